@@ -0,0 +1,8 @@
+package example
+
+// These constants are declared separately from the Animal type, but they are
+// still included in the generated code for Animal.
+const (
+	Bird Animal = iota + 10
+	Fish        // Goldfish
+)
@@ -0,0 +1,14 @@
+package example
+
+// Animal demonstrates enums whose constants are declared across multiple files.
+// See additional_animals.go for the rest of the values.
+//
+// Benchmarks for the generated map lookups are generated as well.
+//
+//go:generate go-enumerator --lookup=map --emit-bench
+type Animal int
+
+const (
+	Dog Animal = iota
+	Cat
+)
@@ -0,0 +1,77 @@
+package example
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestAnimal(t *testing.T) {
+	animals := [4]Animal{
+		Dog, Cat, Bird, Fish,
+	}
+
+	tests := []test[*Animal, string]{
+		{&animals[0], "Dog", new(Animal)},
+		{&animals[1], "Cat", new(Animal)},
+		{&animals[2], "Bird", new(Animal)},
+		{&animals[3], "Goldfish", new(Animal)},
+	}
+
+	doTest(t, tests, func() *Animal {
+		ret := new(Animal)
+		*ret = 2
+		return ret
+	})
+
+	// constants are ordered by file, and then by their position within the file
+	if got := AnimalValues(); !reflect.DeepEqual(got, animals[:]) {
+		t.Errorf("AnimalValues() = %v, want = %v", got, animals)
+	}
+}
+
+func TestParseAnimal(t *testing.T) {
+	got, err := ParseAnimal("Goldfish")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got != Fish {
+		t.Errorf("ParseAnimal() = %v, want = %v", got, Fish)
+	}
+
+	_, err = ParseAnimal("Fish")
+	var invalid *InvalidAnimalError
+	if !errors.As(err, &invalid) || invalid.Value != "Fish" {
+		t.Errorf("ParseAnimal() error = %#v, want *InvalidAnimalError with Value %q", err, "Fish")
+	}
+}
+
+func TestMustParseAnimal(t *testing.T) {
+	if got := MustParseAnimal("Goldfish"); got != Fish {
+		t.Errorf("MustParseAnimal() = %v, want = %v", got, Fish)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("MustParseAnimal() did not panic")
+		}
+	}()
+	MustParseAnimal("Fish")
+}
+
+func TestAnimalOrdinal(t *testing.T) {
+	// ordinals are positions in declaration order, not the underlying values
+	if got := Bird.Ordinal(); got != 2 {
+		t.Errorf("Bird.Ordinal() = %d, want = 2", got)
+	}
+
+	got, err := AnimalFromOrdinal(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got != Fish {
+		t.Errorf("AnimalFromOrdinal(3) = %v, want = %v", got, Fish)
+	}
+}
@@ -0,0 +1,42 @@
+package example
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestAvailability(t *testing.T) {
+	availabilities := [3]Availability{
+		InStock, OutOfStock, Discontinued,
+	}
+
+	tests := []test[*Availability, string]{
+		{&availabilities[0], "In Stock", new(Availability)},
+		{&availabilities[1], "Out of Stock", new(Availability)},
+		{&availabilities[2], "Discontinued", new(Availability)},
+	}
+
+	doTest(t, tests, func() *Availability {
+		ret := new(Availability)
+		*ret = 42
+		return ret
+	})
+}
+
+func TestAvailabilityScan(t *testing.T) {
+	var a, b Availability
+	var n int
+	if _, err := fmt.Sscan("Out of Stock In Stock 3", &a, &b, &n); err != nil {
+		t.Fatal(err)
+	}
+
+	if a != OutOfStock || b != InStock || n != 3 {
+		t.Errorf("Sscan() = %v, %v, %v, want = %v, %v, %v", a, b, n, OutOfStock, InStock, 3)
+	}
+
+	for _, s := range []string{"Out", "Out of", "Sold Out"} {
+		if _, err := fmt.Sscan(s, &a); err == nil {
+			t.Errorf("Sscan(%q) = %v, want error", s, a)
+		}
+	}
+}
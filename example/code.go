@@ -0,0 +1,14 @@
+package example
+
+// Code demonstrates using the underlying values of an integer enum as its string representations,
+// for wire formats keyed on numeric codes.
+//
+//go:generate go-enumerator --string-format=%03d --json
+type Code int
+
+const (
+	CodeOK       Code = 7
+	CodeRetry    Code = 42
+	CodeRejected Code = 120
+	CodeLegacy   Code = 1000 // legacy
+)
@@ -0,0 +1,45 @@
+package example
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestCodeStringFormat(t *testing.T) {
+	for _, tt := range []struct {
+		c    Code
+		want string
+	}{
+		{CodeOK, "007"},
+		{CodeRetry, "042"},
+		{CodeRejected, "120"},
+		{CodeLegacy, "legacy"},
+	} {
+		if got := tt.c.String(); got != tt.want {
+			t.Errorf("%d.String() = %q, want = %q", int(tt.c), got, tt.want)
+		}
+
+		var got Code
+		if err := json.Unmarshal([]byte(`"`+tt.want+`"`), &got); err != nil || got != tt.c {
+			t.Errorf("json.Unmarshal(%q) = %v, %v, want = %v, nil", tt.want, got, err, tt.c)
+		}
+	}
+
+	// only the formatted values are accepted
+	var c Code
+	if err := json.Unmarshal([]byte(`"7"`), &c); err == nil {
+		t.Errorf("json.Unmarshal(\"7\") = %v, want error", c)
+	}
+}
+
+func TestCodeMarshalLenient(t *testing.T) {
+	// without --marshal-strict, undefined values are marshaled using their fallback string representation
+	got, err := Code(5).MarshalText()
+	if want := Code(5).String(); err != nil || string(got) != want {
+		t.Errorf("MarshalText() = %q, %v, want = %q, nil", got, err, want)
+	}
+
+	if got, err := json.Marshal(Code(5)); err != nil || string(got) != `"`+Code(5).String()+`"` {
+		t.Errorf("json.Marshal() = %s, %v, want = %q, nil", got, err, Code(5).String())
+	}
+}
@@ -0,0 +1,121 @@
+package example
+
+import (
+	"bytes"
+	"encoding/gob"
+	"math"
+	"testing"
+)
+
+func TestColor(t *testing.T) {
+	colors := [3]Color{
+		ColorRed, ColorGreen, ColorBlue,
+	}
+
+	tests := []test[*Color, string]{
+		{&colors[0], "ColorRed", new(Color)},
+		{&colors[1], "ColorGreen", new(Color)},
+		{&colors[2], "ColorBlue", new(Color)},
+	}
+
+	doTest(t, tests, func() *Color {
+		ret := new(Color)
+		*ret = math.MaxUint8
+		return ret
+	})
+
+	if got, want := Color(math.MaxUint8).String(), "Color(255)"; got != want {
+		t.Errorf("String() = %v, want = %v", got, want)
+	}
+}
+
+func TestColorBinary(t *testing.T) {
+	b, err := ColorGreen.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(b, []byte{2}) {
+		t.Errorf("MarshalBinary() = %v, want = %v", b, []byte{2})
+	}
+
+	type wrapper struct {
+		Color Color
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(wrapper{ColorBlue}); err != nil {
+		t.Fatal(err)
+	}
+
+	var got wrapper
+	if err := gob.NewDecoder(&buf).Decode(&got); err != nil {
+		t.Fatal(err)
+	}
+
+	if got.Color != ColorBlue {
+		t.Errorf("gob round trip = %v, want = %v", got.Color, ColorBlue)
+	}
+
+	var c Color
+	for _, b := range [][]byte{{}, {1, 2}, {math.MaxUint8}} {
+		if err := c.UnmarshalBinary(b); err == nil {
+			t.Errorf("UnmarshalBinary(%v) = %v, want error", b, c)
+		}
+	}
+}
+
+func TestColorAlias(t *testing.T) {
+	var c Color
+	if err := c.UnmarshalText([]byte("ColorCrimson")); err != nil {
+		t.Fatal(err)
+	}
+
+	if c != ColorRed {
+		t.Errorf("UnmarshalText() = %v, want = %v", c, ColorRed)
+	}
+
+	// the first declared name is used when formatting aliased values
+	if got, want := ColorCrimson.String(), "ColorRed"; got != want {
+		t.Errorf("String() = %v, want = %v", got, want)
+	}
+
+	if got, want := len(ColorValues()), 3; got != want {
+		t.Errorf("len(ColorValues()) = %v, want = %v", got, want)
+	}
+}
+
+func TestColorCount(t *testing.T) {
+	// _ColorCount is a constant, so it can be used as an array length
+	var all [_ColorCount]Color
+	copy(all[:], ColorValues())
+
+	if got, want := ColorCount(), len(all); got != want {
+		t.Errorf("ColorCount() = %v, want = %v", got, want)
+	}
+
+	if got, want := ColorCount(), len(ColorValues()); got != want {
+		t.Errorf("ColorCount() = %v, want = %v", got, want)
+	}
+}
+
+func TestColorProtoMaps(t *testing.T) {
+	if got, want := len(ColorNameMap), ColorCount(); got != want {
+		t.Errorf("len(ColorNameMap) = %v, want = %v", got, want)
+	}
+
+	for _, v := range ColorValues() {
+		if got := ColorNameMap[int32(v)]; got != v.String() {
+			t.Errorf("ColorNameMap[%d] = %q, want = %q", v, got, v.String())
+		}
+
+		if got, ok := ColorValueMap[v.String()]; !ok || got != int32(v) {
+			t.Errorf("ColorValueMap[%q] = %v, %v, want = %v, true", v.String(), got, ok, int32(v))
+		}
+	}
+
+	// like protobuf enums with allow_alias, aliases can be looked up by name
+	if got := ColorValueMap["ColorCrimson"]; got != int32(ColorRed) {
+		t.Errorf("ColorValueMap[%q] = %v, want = %v", "ColorCrimson", got, int32(ColorRed))
+	}
+}
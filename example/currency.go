@@ -0,0 +1,13 @@
+package example
+
+// Currency demonstrates returning a preallocated error when parsing fails,
+// which avoids allocating when parsing untrusted input at high volume.
+//
+//go:generate go-enumerator --sentinel-error --lookup=map --trim-prefix=Currency --naming-strategy=UPPER_SNAKE_CASE
+type Currency int
+
+const (
+	CurrencyEUR Currency = iota
+	CurrencyGBP
+	CurrencyUSD
+)
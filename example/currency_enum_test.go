@@ -0,0 +1,54 @@
+package example
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCurrencySentinelError(t *testing.T) {
+	var c Currency
+	if err := c.UnmarshalText([]byte("bogus")); err != ErrInvalidCurrency {
+		t.Errorf("UnmarshalText() error = %v, want = %v", err, ErrInvalidCurrency)
+	}
+
+	if _, err := ParseCurrency("bogus"); err != ErrInvalidCurrency {
+		t.Errorf("ParseCurrency() error = %v, want = %v", err, ErrInvalidCurrency)
+	}
+
+	// the error type is still generated, for callers that want to report the string
+	var err error = &InvalidCurrencyError{Value: "bogus"}
+	if !errors.Is(err, ErrInvalidCurrency) {
+		t.Errorf("errors.Is(%v, ErrInvalidCurrency) = false, want = true", err)
+	}
+
+	if got, err := ParseCurrency("USD"); err != nil || got != CurrencyUSD {
+		t.Errorf("ParseCurrency() = %v, %v, want = %v, nil", got, err, CurrencyUSD)
+	}
+
+	allocs := testing.AllocsPerRun(100, func() {
+		_, errSink = ParseCurrency("bogus")
+	})
+	if allocs != 0 {
+		t.Errorf("ParseCurrency() allocated %v times, want = 0", allocs)
+	}
+}
+
+// errSink keeps the benchmarked errors from being optimized away
+var errSink error
+
+func BenchmarkParseInvalid(b *testing.B) {
+	b.Run("Currency", func(b *testing.B) {
+		b.ReportAllocs()
+		for n := 0; n < b.N; n++ {
+			_, errSink = ParseCurrency("bogus")
+		}
+	})
+
+	// Animal returns an *InvalidAnimalError
+	b.Run("Animal", func(b *testing.B) {
+		b.ReportAllocs()
+		for n := 0; n < b.N; n++ {
+			_, errSink = ParseAnimal("bogus")
+		}
+	})
+}
@@ -0,0 +1,15 @@
+package example
+
+// Direction demonstrates looping through the values with range-over-func.
+//
+//go:generate go-enumerator --emit-iter --go-version=1.23 --allow-aliases
+type Direction int
+
+const (
+	North Direction = iota
+	East
+	South
+	West
+
+	Up = North // alias
+)
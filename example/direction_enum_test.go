@@ -0,0 +1,35 @@
+//go:build go1.23
+
+package example
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDirectionAll(t *testing.T) {
+	// aliases are skipped, and the values are yielded in the order they are declared
+	got := slices.Collect(DirectionAll())
+	if want := []Direction{North, East, South, West}; !slices.Equal(got, want) {
+		t.Errorf("DirectionAll() = %v, want = %v", got, want)
+	}
+
+	// stopping early doesn't yield the remaining values
+	var first []Direction
+	for d := range DirectionAll() {
+		first = append(first, d)
+		if d == East {
+			break
+		}
+	}
+	if want := []Direction{North, East}; !slices.Equal(first, want) {
+		t.Errorf("DirectionAll() before break = %v, want = %v", first, want)
+	}
+
+	if n := testing.AllocsPerRun(10, func() {
+		for range DirectionAll() {
+		}
+	}); n != 0 {
+		t.Errorf("DirectionAll() allocates %v times, want 0", n)
+	}
+}
@@ -0,0 +1,87 @@
+package enums
+
+import (
+	"testing"
+
+	"github.com/a-jentleman/go-enumerator/example"
+)
+
+func TestKindFunctions(t *testing.T) {
+	tests := []struct {
+		k example.Kind
+		s string
+	}{
+		{example.Kind1, "Kind1"},
+		{example.Kind2, "Kind2"},
+		{example.KindX, "Kind3"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			if got := KindString(tt.k); got != tt.s {
+				t.Errorf("KindString() = %q, want %q", got, tt.s)
+			}
+
+			if got := string(KindBytes(tt.k)); got != tt.s {
+				t.Errorf("KindBytes() = %q, want %q", got, tt.s)
+			}
+
+			if !KindDefined(tt.k) {
+				t.Errorf("KindDefined() = false, want true")
+			}
+
+			if err := KindValidate(tt.k); err != nil {
+				t.Errorf("KindValidate() = %v, want nil", err)
+			}
+
+			k, err := ParseKind(tt.s)
+			if err != nil {
+				t.Fatalf("ParseKind() error = %v", err)
+			}
+
+			if k != tt.k {
+				t.Errorf("ParseKind() = %v, want %v", k, tt.k)
+			}
+		})
+	}
+
+	undefined := example.Kind(-1)
+	if KindDefined(undefined) {
+		t.Errorf("KindDefined(%d) = true, want false", undefined)
+	}
+
+	if err := KindValidate(undefined); err == nil {
+		t.Errorf("KindValidate(%d) = nil, want error", undefined)
+	}
+
+	if got, want := KindString(undefined), "Kind(-1)"; got != want {
+		t.Errorf("KindString(%d) = %q, want %q", undefined, got, want)
+	}
+
+	if _, err := ParseKind("Kind4"); err == nil {
+		t.Errorf("ParseKind(%q) error = nil, want error", "Kind4")
+	}
+
+	if got := MustParseKind("Kind3"); got != example.KindX {
+		t.Errorf("MustParseKind(%q) = %v, want %v", "Kind3", got, example.KindX)
+	}
+}
+
+func TestKindFunctionsNextPrev(t *testing.T) {
+	values := KindValues()
+	k := values[0]
+	for i := range values {
+		if k != values[i] {
+			t.Errorf("KindNext() = %v, want %v", k, values[i])
+		}
+		k = KindNext(k)
+	}
+
+	if k != values[0] {
+		t.Errorf("KindNext() did not wrap around: got %v, want %v", k, values[0])
+	}
+
+	if got, want := KindPrev(values[0]), values[len(values)-1]; got != want {
+		t.Errorf("KindPrev() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,18 @@
+package example
+
+// Episode demonstrates binding an enum to a GraphQL enum with gqlgen. The
+// GraphQL enum values are written in UPPER_SNAKE_CASE, so gqlgen.yml maps the
+// Episode type of the schema to this type:
+//
+//	models:
+//	  Episode:
+//	    model: example.Episode
+//
+//go:generate go-enumerator --gqlgen --auto-trim-prefix --naming-strategy=UPPER_SNAKE_CASE
+type Episode int
+
+const (
+	EpisodeNewHope Episode = iota
+	EpisodeEmpire
+	EpisodeJedi
+)
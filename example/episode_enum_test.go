@@ -0,0 +1,50 @@
+package example
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEpisode(t *testing.T) {
+	episodes := [3]Episode{
+		EpisodeNewHope, EpisodeEmpire, EpisodeJedi,
+	}
+
+	tests := []test[*Episode, string]{
+		{&episodes[0], "NEW_HOPE", new(Episode)},
+		{&episodes[1], "EMPIRE", new(Episode)},
+		{&episodes[2], "JEDI", new(Episode)},
+	}
+
+	doTest(t, tests, func() *Episode {
+		ret := new(Episode)
+		*ret = 3
+		return ret
+	})
+}
+
+func TestEpisodeGQL(t *testing.T) {
+	var b strings.Builder
+	EpisodeNewHope.MarshalGQL(&b)
+
+	if got, want := b.String(), `"NEW_HOPE"`; got != want {
+		t.Errorf("EpisodeNewHope.MarshalGQL() = %s, want = %s", got, want)
+	}
+
+	var e Episode
+	if err := e.UnmarshalGQL("JEDI"); err != nil {
+		t.Fatal(err)
+	}
+
+	if e != EpisodeJedi {
+		t.Errorf("UnmarshalGQL(%q) = %v, want = %v", "JEDI", e, EpisodeJedi)
+	}
+
+	if err := e.UnmarshalGQL("SITH"); err == nil {
+		t.Errorf("UnmarshalGQL(%q) returned no error", "SITH")
+	}
+
+	if err := e.UnmarshalGQL(2); err == nil {
+		t.Errorf("UnmarshalGQL(%d) returned no error", 2)
+	}
+}
@@ -2,7 +2,9 @@ package example
 
 // Kind demonstrates integer style enums
 //
-//go:generate go-enumerator
+//go:generate go-enumerator --json --slog --yaml=v3 --emit-random
+//go:generate go-enumerator --type=Kind --functions --output-pkg=enums --output=enums/kind_enum.go
+//go:generate go-enumerator --type=Kind --emit-json
 type Kind int
 
 const (
@@ -13,7 +15,7 @@ const (
 
 // StrKind demonstrates string style enums
 //
-//go:generate go-enumerator
+//go:generate go-enumerator --case-insensitive --xml --xml-attr
 type StrKind string
 
 const (
@@ -21,3 +23,158 @@ const (
 	World StrKind = "World"
 	Bang  StrKind = "Bang" // Override
 )
+
+// Status demonstrates enums that are stored in a database, where legacy rows may hold numbers
+//
+//go:generate go-enumerator --sql --trim-prefix=Status --check-blanks --accept-numeric
+type Status int
+
+const (
+	StatusActive   Status = iota + 1 // desc:"The account can be used"
+	StatusInactive                   // desc:"The account is temporarily disabled"
+	_                                // previously used for a status that no longer exists, so it must not be reused
+	StatusDeleted                    // enum:"Removed" desc:"The account has been deleted"
+)
+
+// Color demonstrates enums with an unsigned underlying type
+//
+//go:generate go-enumerator --binary --allow-aliases --proto-maps
+type Color uint8
+
+const (
+	ColorRed Color = iota + 1
+	ColorGreen
+	ColorBlue
+
+	// ColorCrimson is an alias of ColorRed. ColorRed is used when formatting the value.
+	ColorCrimson = ColorRed
+)
+
+// Ratio demonstrates float style enums
+//
+//go:generate go-enumerator
+type Ratio float64
+
+const (
+	RatioQuarter Ratio = 0.25
+	RatioThird   Ratio = 1.0 / 3
+	RatioHalf    Ratio = 0.5
+	RatioWhole   Ratio = 1
+)
+
+// Permission demonstrates bit flag style enums
+//
+//go:generate go-enumerator --flags --trim-prefix=Permission
+type Permission uint8
+
+const (
+	PermissionRead Permission = 1 << iota
+	PermissionWrite
+	PermissionExecute
+	PermissionAll = PermissionRead | PermissionWrite | PermissionExecute
+)
+
+// Weekday demonstrates generating a test file along with the enum, constants for the smallest
+// and largest values, a String method that looks up names in a table, and an Is<Name> method per day
+//
+//go:generate go-enumerator --emit-test --min-max --stringer-style --emit-predicates --header "SPDX-License-Identifier: MIT"
+type Weekday int
+
+const (
+	Monday Weekday = iota
+	Tuesday
+	Wednesday
+	Thursday
+	Friday
+	Saturday
+	Sunday
+)
+
+// OrderStatus demonstrates enums whose string representations carry a namespace
+//
+//go:generate go-enumerator --trim-prefix=OrderStatus --naming-strategy=snake_case --prefix=order_status.
+type OrderStatus int
+
+const (
+	OrderStatusActive OrderStatus = iota
+	OrderStatusShipped
+	OrderStatusCancelled // cancelled
+)
+
+// Level demonstrates enums with a narrow signed underlying type whose values are near its limits
+//
+// The doc comments of the constants are used as their descriptions.
+//
+//go:generate go-enumerator --binary --descriptions
+type Level int8
+
+const (
+	// LevelMin is the lowest level.
+	LevelMin Level = -128
+
+	// LevelDefault is used when no
+	// level is specified.
+	LevelDefault Level = 0
+
+	LevelMax Level = 127 // desc:"The highest level"
+)
+
+// Port demonstrates enums with a 16-bit underlying type, written to a file named using a template
+//
+//go:generate go-enumerator "--output-template={{.Type | lower}}_generated.go"
+type Port int16
+
+const (
+	PortHTTP  Port = 80
+	PortHTTPS Port = 443
+	PortAlt   Port = 8080
+)
+
+// Region demonstrates enums that use pointer receivers, and sorting values in the order they are declared
+//
+//go:generate go-enumerator --receiver-pointer --emit-sort
+type Region string
+
+const (
+	RegionNorthAmerica Region = "north-america"
+	RegionEurope       Region = "europe"
+	RegionAsiaPacific  Region = "asia-pacific"
+)
+
+// Role demonstrates generating a set type for collections of values
+//
+// RoleUnknown is excluded, so it is not a defined value.
+//
+//go:generate go-enumerator --set --json --formatter --exclude=RoleUnknown --strict-cases
+type Role int
+
+const (
+	RoleUnknown Role = iota
+	RoleViewer
+	RoleEditor
+	RoleAdmin
+)
+
+// Suit demonstrates enums of characters. Values that are not defined are formatted as quoted characters.
+// SuitValues is an array, so its length is a constant.
+//
+//go:generate go-enumerator --values-style=array
+type Suit rune
+
+const (
+	SuitSpades   Suit = '♠' // ♠
+	SuitHearts   Suit = '♥' // ♥
+	SuitDiamonds Suit = '♦'
+	SuitClubs    Suit = '♣'
+)
+
+// Availability demonstrates enums whose string representations contain spaces
+//
+//go:generate go-enumerator --scan=values --strict
+type Availability int
+
+const (
+	InStock    Availability = iota // In Stock
+	OutOfStock                     // Out of Stock
+	Discontinued
+)
@@ -0,0 +1,23 @@
+package example
+
+// Format demonstrates parsing values from data with stray spaces, such as CSV columns.
+//
+//go:generate go-enumerator --trim-space --lookup=map --trim-prefix=Format --naming-strategy=snake_case
+type Format int
+
+const (
+	FormatCSV Format = iota
+	FormatTSV
+	FormatJSONLines
+)
+
+// Feature demonstrates parsing combined bit flags with spaces around each flag, such as in config files.
+//
+//go:generate go-enumerator --flags --trim-space --trim-prefix=Feature
+type Feature uint8
+
+const (
+	FeatureCache Feature = 1 << iota
+	FeatureRetry
+	FeatureTrace
+)
@@ -0,0 +1,64 @@
+package example
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestFormatTrimSpace(t *testing.T) {
+	for _, tt := range []struct {
+		s    string
+		want Format
+	}{
+		{"csv", FormatCSV},
+		{" tsv", FormatTSV},
+		{"json_lines ", FormatJSONLines},
+		{"\t csv\r\n", FormatCSV},
+	} {
+		var got Format
+		if err := got.UnmarshalText([]byte(tt.s)); err != nil || got != tt.want {
+			t.Errorf("UnmarshalText(%q) = %v, %v, want = %v, nil", tt.s, got, err, tt.want)
+		}
+
+		if got, err := ParseFormat(tt.s); err != nil || got != tt.want {
+			t.Errorf("ParseFormat(%q) = %v, %v, want = %v, nil", tt.s, got, err, tt.want)
+		}
+
+		got = 0
+		if _, err := fmt.Sscan(tt.s, &got); err != nil || got != tt.want {
+			t.Errorf("fmt.Sscan(%q) = %v, %v, want = %v, nil", tt.s, got, err, tt.want)
+		}
+	}
+
+	// spaces inside a value are not removed
+	for _, s := range []string{"", "  ", "c sv", "json lines"} {
+		if got, err := ParseFormat(s); err == nil {
+			t.Errorf("ParseFormat(%q) = %v, want error", s, got)
+		}
+	}
+}
+
+func TestFeatureTrimSpace(t *testing.T) {
+	for _, tt := range []struct {
+		s    string
+		want Feature
+	}{
+		{"Cache|Retry", FeatureCache | FeatureRetry},
+		{"Cache | Retry", FeatureCache | FeatureRetry},
+		{" Cache |Trace ", FeatureCache | FeatureTrace},
+		{"\tRetry\n", FeatureRetry},
+		{"  ", 0},
+	} {
+		var got Feature
+		if err := got.UnmarshalText([]byte(tt.s)); err != nil || got != tt.want {
+			t.Errorf("UnmarshalText(%q) = %v, %v, want = %v, nil", tt.s, got, err, tt.want)
+		}
+	}
+
+	for _, s := range []string{"Cache||Retry", "Cache | Re try"} {
+		var got Feature
+		if err := got.UnmarshalText([]byte(s)); err == nil {
+			t.Errorf("UnmarshalText(%q) = %v, want error", s, got)
+		}
+	}
+}
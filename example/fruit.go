@@ -0,0 +1,11 @@
+package example
+
+// The constants below demonstrate generating code for constants that are declared
+// without a named type. The generated file declares Fruit as an alias of string.
+//
+//go:generate go-enumerator --functions --group=Fruit
+const (
+	Apple  = "apple"
+	Banana = "banana"
+	Cherry = "cherry"
+)
@@ -0,0 +1,35 @@
+package example
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestFruit(t *testing.T) {
+	for _, f := range []Fruit{Apple, Banana, Cherry} {
+		if !FruitDefined(f) {
+			t.Errorf("FruitDefined(%q) = false, want = true", f)
+		}
+
+		parsed, err := ParseFruit(FruitString(f))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if parsed != f {
+			t.Errorf("ParseFruit(%q) = %q, want = %q", FruitString(f), parsed, f)
+		}
+	}
+
+	if FruitDefined("durian") {
+		t.Errorf("FruitDefined(%q) = true, want = false", "durian")
+	}
+
+	if _, err := ParseFruit("durian"); err == nil {
+		t.Errorf("ParseFruit(%q) returned no error", "durian")
+	}
+
+	if got, want := FruitValues(), []string{"apple", "banana", "cherry"}; !slices.Equal(got, want) {
+		t.Errorf("FruitValues() = %v, want = %v", got, want)
+	}
+}
@@ -2,9 +2,17 @@ package example
 
 import (
 	"encoding"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
 	"fmt"
+	"log/slog"
+	"math/rand"
 	"reflect"
 	"testing"
+
+	"github.com/pelletier/go-toml/v2"
+	"gopkg.in/yaml.v3"
 )
 
 func TestStrKind(t *testing.T) {
@@ -25,6 +33,42 @@ func TestStrKind(t *testing.T) {
 	})
 }
 
+func TestStrKindCaseInsensitive(t *testing.T) {
+	tests := []struct {
+		str  string
+		want StrKind
+	}{
+		{"hello", Hello},
+		{"WORLD", World},
+		{"oVeRrIdE", Bang},
+	}
+
+	for _, test := range tests {
+		var got StrKind
+		if err := got.UnmarshalText([]byte(test.str)); err != nil {
+			t.Errorf("UnmarshalText(%q) returned error: %v", test.str, err)
+		}
+
+		if got != test.want {
+			t.Errorf("UnmarshalText(%q) = %v, want = %v", test.str, got, test.want)
+		}
+
+		got = ""
+		if _, err := fmt.Sscan(test.str, &got); err != nil {
+			t.Errorf("Scan(%q) returned error: %v", test.str, err)
+		}
+
+		if got != test.want {
+			t.Errorf("Scan(%q) = %v, want = %v", test.str, got, test.want)
+		}
+	}
+
+	var got StrKind
+	if err := got.UnmarshalText([]byte("Bang")); err == nil {
+		t.Errorf("UnmarshalText(%q) = %v, want error", "Bang", got)
+	}
+}
+
 func TestKind(t *testing.T) {
 	kinds := [3]Kind{
 		Kind1, Kind2, KindX,
@@ -43,6 +87,215 @@ func TestKind(t *testing.T) {
 	})
 }
 
+func TestKindValues(t *testing.T) {
+	wantValues := []Kind{Kind1, Kind2, KindX}
+	if got := KindValues(); !reflect.DeepEqual(got, wantValues) {
+		t.Errorf("KindValues() = %v, want = %v", got, wantValues)
+	}
+
+	wantStrings := []string{"Kind1", "Kind2", "Kind3"}
+	if got := KindStrings(); !reflect.DeepEqual(got, wantStrings) {
+		t.Errorf("KindStrings() = %v, want = %v", got, wantStrings)
+	}
+}
+
+func TestKindEntries(t *testing.T) {
+	entries := KindEntries()
+	if got, want := len(entries), KindCount(); got != want {
+		t.Fatalf("len(KindEntries()) = %v, want = %v", got, want)
+	}
+
+	values, strs := KindValues(), KindStrings()
+	for i, e := range entries {
+		if e.Name != strs[i] || e.Value != values[i] {
+			t.Errorf("KindEntries()[%d] = %+v, want = {Name:%s Value:%v}", i, e, strs[i], values[i])
+		}
+	}
+
+	// the entries are copied, so modifying them doesn't affect later calls
+	entries[0].Name = "modified"
+	if got := KindEntries()[0].Name; got != "Kind1" {
+		t.Errorf("KindEntries()[0].Name = %q after modifying a previous result, want = %q", got, "Kind1")
+	}
+}
+
+// TestKindRandom uses KindRandom the way property tests would, checking that
+// every value survives a JSON round trip.
+func TestKindRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	seen := make(map[Kind]bool)
+	for i := 0; i < 100; i++ {
+		want := KindRandom(r)
+		if !want.Defined() {
+			t.Fatalf("KindRandom() = %v, which is not defined", want)
+		}
+
+		seen[want] = true
+
+		b, err := json.Marshal(want)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var got Kind
+		if err := json.Unmarshal(b, &got); err != nil {
+			t.Fatal(err)
+		}
+
+		if got != want {
+			t.Errorf("json round trip of %v = %v", want, got)
+		}
+	}
+
+	if got, want := len(seen), KindCount(); got != want {
+		t.Errorf("KindRandom() returned %d distinct values in 100 calls, want = %d", got, want)
+	}
+}
+
+func TestStrKindValues(t *testing.T) {
+	wantValues := []StrKind{Hello, World, Bang}
+	if got := StrKindValues(); !reflect.DeepEqual(got, wantValues) {
+		t.Errorf("StrKindValues() = %v, want = %v", got, wantValues)
+	}
+
+	wantStrings := []string{"Hello", "World", "Override"}
+	if got := StrKindStrings(); !reflect.DeepEqual(got, wantStrings) {
+		t.Errorf("StrKindStrings() = %v, want = %v", got, wantStrings)
+	}
+}
+
+func TestKindNextPrev(t *testing.T) {
+	values := KindValues()
+	for i, k := range values {
+		next := values[(i+1)%len(values)]
+		if got := k.Next(); got != next {
+			t.Errorf("%v.Next() = %v, want = %v", k, got, next)
+		}
+
+		prev := values[(i+len(values)-1)%len(values)]
+		if got := k.Prev(); got != prev {
+			t.Errorf("%v.Prev() = %v, want = %v", k, got, prev)
+		}
+	}
+
+	if got := Kind(-1).Next(); got != Kind1 {
+		t.Errorf("Next() = %v, want = %v", got, Kind1)
+	}
+
+	if got := Kind(-1).Prev(); got != KindX {
+		t.Errorf("Prev() = %v, want = %v", got, KindX)
+	}
+}
+
+func TestStrKindNextPrev(t *testing.T) {
+	values := StrKindValues()
+	for i, s := range values {
+		next := values[(i+1)%len(values)]
+		if got := s.Next(); got != next {
+			t.Errorf("%v.Next() = %v, want = %v", s, got, next)
+		}
+
+		prev := values[(i+len(values)-1)%len(values)]
+		if got := s.Prev(); got != prev {
+			t.Errorf("%v.Prev() = %v, want = %v", s, got, prev)
+		}
+	}
+
+	if got := StrKind("").Next(); got != Hello {
+		t.Errorf("Next() = %v, want = %v", got, Hello)
+	}
+
+	if got := StrKind("").Prev(); got != Bang {
+		t.Errorf("Prev() = %v, want = %v", got, Bang)
+	}
+}
+
+func TestKindJSON(t *testing.T) {
+	type wrapper struct {
+		Kind Kind `json:"kind"`
+	}
+
+	want := wrapper{Kind: KindX}
+	b, err := json.Marshal(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(b) != `{"kind":"Kind3"}` {
+		t.Errorf("json.Marshal() = %s, want = %s", b, `{"kind":"Kind3"}`)
+	}
+
+	var got wrapper
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+
+	if got != want {
+		t.Errorf("json.Unmarshal() = %v, want = %v", got, want)
+	}
+
+	if err := json.Unmarshal([]byte(`{"kind":"bogus"}`), &got); err == nil {
+		t.Errorf("json.Unmarshal() succeeded for an undefined value")
+	}
+
+	got = wrapper{Kind: Kind2}
+	if err := json.Unmarshal([]byte(`{"kind":null}`), &got); err != nil {
+		t.Fatal(err)
+	}
+
+	if got.Kind != Kind2 {
+		t.Errorf("json.Unmarshal(null) = %v, want = %v", got.Kind, Kind2)
+	}
+}
+
+// TestKindTOML checks the contract TOML libraries such as github.com/BurntSushi/toml and
+// github.com/pelletier/go-toml/v2 rely on: the output of MarshalText is written as a TOML string,
+// and the unquoted contents of TOML strings are passed to UnmarshalText. The library isn't
+// imported, so a key/value line is encoded and decoded by hand instead.
+func TestKindTOML(t *testing.T) {
+	type config struct {
+		Kind Kind `toml:"kind"`
+	}
+
+	b, err := toml.Marshal(config{KindX})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := string(b), "kind = 'Kind3'\n"; got != want {
+		t.Errorf("toml.Marshal() = %q, want = %q", got, want)
+	}
+
+	var got config
+	if err := toml.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+
+	if got.Kind != KindX {
+		t.Errorf("toml.Unmarshal() = %v, want = %v", got.Kind, KindX)
+	}
+
+	// the quotes are removed by the TOML library, so UnmarshalText receives the bare string representation
+	if err := toml.Unmarshal([]byte(`kind = "Kind1"`), &got); err != nil || got.Kind != Kind1 {
+		t.Errorf("toml.Unmarshal() = %v, %v, want = %v, nil", got.Kind, err, Kind1)
+	}
+
+	if err := toml.Unmarshal([]byte(`kind = "bogus"`), &got); err == nil {
+		t.Errorf("toml.Unmarshal() accepted an undefined value")
+	}
+}
+
+func TestKindLogValue(t *testing.T) {
+	v := KindX.LogValue()
+	if v.Kind() != slog.KindString {
+		t.Errorf("LogValue().Kind() = %v, want = %v", v.Kind(), slog.KindString)
+	}
+
+	if got := v.String(); got != "Kind3" {
+		t.Errorf("LogValue() = %v, want = %v", got, "Kind3")
+	}
+}
+
 type kindLike interface {
 	Bytes() []byte
 	fmt.Stringer
@@ -50,6 +303,7 @@ type kindLike interface {
 	encoding.TextMarshaler
 	encoding.TextUnmarshaler
 	Defined() bool
+	Validate() error
 }
 
 type test[sutT kindLike, repr string | int] struct {
@@ -131,4 +385,128 @@ func doTest[sutT kindLike, Repr string | int](t *testing.T, tests []test[sutT, R
 			t.Errorf("Defined() = %v, want = %v", true, false)
 		}
 	})
+
+	t.Run("Validate", func(t *testing.T) {
+		for _, test := range tests {
+			if err := test.sut.Validate(); err != nil {
+				t.Errorf("Validate() = %v, want = %v", err, nil)
+			}
+		}
+
+		invalid := invalidFunc()
+		if err := invalid.Validate(); err == nil {
+			t.Errorf("Validate() = %v, want error", err)
+		}
+	})
+}
+
+func TestKindInvalidValueError(t *testing.T) {
+	var k Kind
+	err := k.UnmarshalText([]byte("bogus"))
+	if err == nil {
+		t.Fatal("UnmarshalText() expected error")
+	}
+
+	if got, want := err.Error(), `"bogus" is not a valid Kind (must be one of Kind1, Kind2, Kind3)`; got != want {
+		t.Errorf("UnmarshalText() error = %v, want = %v", got, want)
+	}
+
+	var invalid *InvalidKindError
+	if !errors.As(err, &invalid) || invalid.Value != "bogus" {
+		t.Errorf("UnmarshalText() error = %#v, want *InvalidKindError with Value %q", err, "bogus")
+	}
+
+	// fmt wraps errors returned by Scan
+	_, err = fmt.Sscan("bogus", &k)
+	if !errors.As(err, &invalid) || invalid.Value != "bogus" {
+		t.Errorf("Scan() error = %#v, want *InvalidKindError with Value %q", err, "bogus")
+	}
+}
+
+func TestKindOrdinal(t *testing.T) {
+	for i, k := range KindValues() {
+		if got := k.Ordinal(); got != i {
+			t.Errorf("%v.Ordinal() = %d, want = %d", k, got, i)
+		}
+
+		got, err := KindFromOrdinal(i)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got != k {
+			t.Errorf("KindFromOrdinal(%d) = %v, want = %v", i, got, k)
+		}
+	}
+
+	if got := Kind(-1).Ordinal(); got != -1 {
+		t.Errorf("Ordinal() = %d, want = -1", got)
+	}
+
+	if _, err := KindFromOrdinal(len(KindValues())); err == nil {
+		t.Errorf("KindFromOrdinal() expected error")
+	}
+}
+
+func TestKindYAML(t *testing.T) {
+	type config struct {
+		Kind Kind `yaml:"kind"`
+	}
+
+	b, err := yaml.Marshal(config{KindX})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := string(b), "kind: Kind3\n"; got != want {
+		t.Errorf("yaml.Marshal() = %q, want = %q", got, want)
+	}
+
+	var got config
+	if err := yaml.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+
+	if got.Kind != KindX {
+		t.Errorf("yaml.Unmarshal() = %v, want = %v", got.Kind, KindX)
+	}
+
+	if err := yaml.Unmarshal([]byte("kind: bogus\n"), &got); err == nil {
+		t.Errorf("yaml.Unmarshal() expected error")
+	}
+}
+
+func TestStrKindXML(t *testing.T) {
+	type message struct {
+		XMLName xml.Name `xml:"message"`
+		Kind    StrKind  `xml:"kind"`
+		Attr    StrKind  `xml:"attr,attr"`
+	}
+
+	b, err := xml.Marshal(message{Kind: Bang, Attr: World})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := string(b), `<message attr="World"><kind>Override</kind></message>`; got != want {
+		t.Errorf("xml.Marshal() = %s, want = %s", got, want)
+	}
+
+	var got message
+	if err := xml.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+
+	if got.Kind != Bang || got.Attr != World {
+		t.Errorf("xml.Unmarshal() = %v, %v, want = %v, %v", got.Kind, got.Attr, Bang, World)
+	}
+
+	for _, s := range []string{
+		`<message><kind>bogus</kind></message>`,
+		`<message attr="bogus"></message>`,
+	} {
+		if err := xml.Unmarshal([]byte(s), &got); err == nil {
+			t.Errorf("xml.Unmarshal(%s) expected error", s)
+		}
+	}
 }
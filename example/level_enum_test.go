@@ -0,0 +1,66 @@
+package example
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestLevel(t *testing.T) {
+	levels := [3]Level{
+		LevelMin, LevelDefault, LevelMax,
+	}
+
+	tests := []test[*Level, string]{
+		{&levels[0], "LevelMin", new(Level)},
+		{&levels[1], "LevelDefault", new(Level)},
+		{&levels[2], "LevelMax", new(Level)},
+	}
+
+	doTest(t, tests, func() *Level {
+		ret := new(Level)
+		*ret = 1
+		return ret
+	})
+
+	if got, want := Level(-1).String(), "Level(-1)"; got != want {
+		t.Errorf("String() = %v, want = %v", got, want)
+	}
+}
+
+func TestLevelBinary(t *testing.T) {
+	b, err := LevelMin.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(b, []byte{0x80}) {
+		t.Errorf("MarshalBinary() = %v, want = %v", b, []byte{0x80})
+	}
+
+	var got Level
+	if err := got.UnmarshalBinary(b); err != nil {
+		t.Fatal(err)
+	}
+
+	if got != LevelMin {
+		t.Errorf("UnmarshalBinary() = %v, want = %v", got, LevelMin)
+	}
+}
+
+func TestLevelDescription(t *testing.T) {
+	tests := []struct {
+		sut  Level
+		want string
+	}{
+		{LevelMin, "LevelMin is the lowest level."},
+		{LevelDefault, "LevelDefault is used when no level is specified."},
+		{LevelMax, "The highest level"},
+		{Level(1), ""},
+	}
+
+	for _, test := range tests {
+		if got := test.sut.Description(); got != test.want {
+			t.Errorf("Description() = %v, want = %v", got, test.want)
+		}
+	}
+}
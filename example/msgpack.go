@@ -0,0 +1,24 @@
+package example
+
+// Compression demonstrates encoding values as msgpack strings for github.com/vmihailenco/msgpack,
+// which calls MarshalMsgpack and UnmarshalMsgpack without the package being imported here.
+//
+//go:generate go-enumerator --msgpack --trim-prefix=Compression --naming-strategy=snake_case
+type Compression int
+
+const (
+	CompressionNone Compression = iota
+	CompressionGzip
+	CompressionZstd
+)
+
+// Offset demonstrates encoding values as msgpack integers instead.
+//
+//go:generate go-enumerator --msgpack=int
+type Offset int16
+
+const (
+	OffsetBefore Offset = -100
+	OffsetNone   Offset = 0
+	OffsetAfter  Offset = 300
+)
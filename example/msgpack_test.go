@@ -0,0 +1,122 @@
+package example
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestCompressionMsgpack(t *testing.T) {
+	for _, tt := range []struct {
+		c    Compression
+		want []byte
+	}{
+		{CompressionNone, []byte{0xa4, 'n', 'o', 'n', 'e'}},
+		{CompressionGzip, []byte{0xa4, 'g', 'z', 'i', 'p'}},
+		{CompressionZstd, []byte{0xa4, 'z', 's', 't', 'd'}},
+	} {
+		got, err := tt.c.MarshalMsgpack()
+		if err != nil || !bytes.Equal(got, tt.want) {
+			t.Errorf("%v.MarshalMsgpack() = %#x, %v, want = %#x, nil", tt.c, got, err, tt.want)
+		}
+
+		var c Compression
+		if err := c.UnmarshalMsgpack(got); err != nil || c != tt.c {
+			t.Errorf("UnmarshalMsgpack(%#x) = %v, %v, want = %v, nil", got, c, err, tt.c)
+		}
+	}
+
+	// every str format is accepted, not only the smallest one
+	for _, b := range [][]byte{
+		{0xd9, 4, 'g', 'z', 'i', 'p'},
+		{0xda, 0, 4, 'g', 'z', 'i', 'p'},
+		{0xdb, 0, 0, 0, 4, 'g', 'z', 'i', 'p'},
+	} {
+		var c Compression
+		if err := c.UnmarshalMsgpack(b); err != nil || c != CompressionGzip {
+			t.Errorf("UnmarshalMsgpack(%#x) = %v, %v, want = %v, nil", b, c, err, CompressionGzip)
+		}
+	}
+
+	// undefined values are encoded using their string representation
+	long := Compression(1 << 40)
+	got, err := long.MarshalMsgpack()
+	if want := append([]byte{0xa0 | byte(len(long.String()))}, long.String()...); err != nil || !bytes.Equal(got, want) {
+		t.Errorf("%v.MarshalMsgpack() = %#x, %v, want = %#x, nil", long, got, err, want)
+	}
+
+	for _, b := range [][]byte{
+		nil,
+		{0x01},
+		{0xa4, 'g', 'z', 'i'},
+		{0xa4, 'g', 'z', 'i', 'p', 0},
+		{0xa3, 'z', 'i', 'p'},
+		append([]byte{0xda, 0x01, 0x00}, strings.Repeat("x", 256)...),
+	} {
+		var c Compression
+		if err := c.UnmarshalMsgpack(b); err == nil {
+			t.Errorf("UnmarshalMsgpack(%#x) = %v, want error", b, c)
+		}
+	}
+}
+
+func TestOffsetMsgpack(t *testing.T) {
+	for _, tt := range []struct {
+		o    Offset
+		want []byte
+	}{
+		{OffsetBefore, []byte{0xd3, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x9c}},
+		{OffsetNone, []byte{0x00}},
+		{OffsetAfter, []byte{0xd3, 0, 0, 0, 0, 0, 0, 0x01, 0x2c}},
+		{Offset(-1), []byte{0xff}},
+	} {
+		got, err := tt.o.MarshalMsgpack()
+		if err != nil || !bytes.Equal(got, tt.want) {
+			t.Errorf("%v.MarshalMsgpack() = %#x, %v, want = %#x, nil", tt.o, got, err, tt.want)
+		}
+
+		if !tt.o.Defined() {
+			continue
+		}
+
+		var o Offset
+		if err := o.UnmarshalMsgpack(got); err != nil || o != tt.o {
+			t.Errorf("UnmarshalMsgpack(%#x) = %v, %v, want = %v, nil", got, o, err, tt.o)
+		}
+	}
+
+	// every int and uint format is accepted, not only the ones used by MarshalMsgpack
+	for _, tt := range []struct {
+		b    []byte
+		want Offset
+	}{
+		{[]byte{0xd0, 0x9c}, OffsetBefore},
+		{[]byte{0xd1, 0xff, 0x9c}, OffsetBefore},
+		{[]byte{0xd2, 0xff, 0xff, 0xff, 0x9c}, OffsetBefore},
+		{[]byte{0xcc, 0x00}, OffsetNone},
+		{[]byte{0xcd, 0x01, 0x2c}, OffsetAfter},
+		{[]byte{0xce, 0, 0, 0x01, 0x2c}, OffsetAfter},
+		{[]byte{0xcf, 0, 0, 0, 0, 0, 0, 0x01, 0x2c}, OffsetAfter},
+	} {
+		var o Offset
+		if err := o.UnmarshalMsgpack(tt.b); err != nil || o != tt.want {
+			t.Errorf("UnmarshalMsgpack(%#x) = %v, %v, want = %v, nil", tt.b, o, err, tt.want)
+		}
+	}
+
+	for _, b := range [][]byte{
+		nil,
+		{0x01},
+		{0xa1, '0'},
+		{0xcd, 0x01},
+		{0xd1, 0x01, 0x2c, 0x00},
+		// 300 + 65536 doesn't fit in an int16
+		{0xce, 0, 0x01, 0x01, 0x2c},
+		{0xcf, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x9c},
+	} {
+		var o Offset
+		if err := o.UnmarshalMsgpack(b); err == nil {
+			t.Errorf("UnmarshalMsgpack(%#x) = %v, want error", b, o)
+		}
+	}
+}
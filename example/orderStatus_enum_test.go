@@ -0,0 +1,31 @@
+package example
+
+import (
+	"testing"
+)
+
+func TestOrderStatusString(t *testing.T) {
+	tests := []struct {
+		sut  OrderStatus
+		want string
+	}{
+		{OrderStatusActive, "order_status.active"},
+		{OrderStatusShipped, "order_status.shipped"},
+		{OrderStatusCancelled, "cancelled"},
+	}
+
+	for _, test := range tests {
+		if got := test.sut.String(); got != test.want {
+			t.Errorf("String() = %v, want = %v", got, test.want)
+		}
+
+		var got OrderStatus
+		if err := got.UnmarshalText([]byte(test.want)); err != nil {
+			t.Fatal(err)
+		}
+
+		if got != test.sut {
+			t.Errorf("UnmarshalText() = %v, want = %v", got, test.sut)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package example
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+func TestPermission(t *testing.T) {
+	permissions := [5]Permission{
+		PermissionRead, PermissionWrite, PermissionExecute, PermissionAll, PermissionRead | PermissionExecute,
+	}
+
+	tests := []test[*Permission, string]{
+		{&permissions[0], "Read", new(Permission)},
+		{&permissions[1], "Write", new(Permission)},
+		{&permissions[2], "Execute", new(Permission)},
+		{&permissions[3], "All", new(Permission)},
+		{&permissions[4], "Read|Execute", new(Permission)},
+	}
+
+	doTest(t, tests, func() *Permission {
+		ret := new(Permission)
+		*ret = 8
+		return ret
+	})
+
+	// no flags is defined, so the zero value of a field round-trips
+	if got, want := Permission(0).String(), ""; got != want {
+		t.Errorf("String() = %v, want = %v", got, want)
+	}
+
+	type file struct {
+		Mode Permission
+	}
+
+	b, err := json.Marshal(file{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := string(b), `{"Mode":""}`; got != want {
+		t.Errorf("json.Marshal() = %v, want = %v", got, want)
+	}
+
+	got := file{Mode: PermissionAll}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+
+	if got.Mode != 0 || got.Mode.Validate() != nil {
+		t.Errorf("json.Unmarshal() = %v, want = %v", got.Mode, Permission(0))
+	}
+}
+
+func TestPermissionFlags(t *testing.T) {
+	p := PermissionRead.Set(PermissionWrite)
+	if !p.Has(PermissionRead) || !p.Has(PermissionWrite) || !p.Has(PermissionRead|PermissionWrite) {
+		t.Errorf("%v.Has() = false, want = true", p)
+	}
+
+	if p.Has(PermissionExecute) {
+		t.Errorf("%v.Has(%v) = true, want = false", p, PermissionExecute)
+	}
+
+	p = p.Clear(PermissionRead)
+	if p != PermissionWrite {
+		t.Errorf("Clear() = %v, want = %v", p, PermissionWrite)
+	}
+
+	var got Permission
+	if _, err := fmt.Sscan("Write|Read", &got); err != nil {
+		t.Fatal(err)
+	}
+
+	if want := PermissionRead | PermissionWrite; got != want {
+		t.Errorf("Scan() = %v, want = %v", got, want)
+	}
+
+	if err := got.UnmarshalText([]byte("Read|Bogus")); err == nil {
+		t.Errorf("UnmarshalText() expected error")
+	}
+}
@@ -0,0 +1,14 @@
+package example
+
+// Pill demonstrates replacing enumer. The directive was //go:generate enumer -type=Pill -json,
+// and the code that uses PillString, PillValues and IsAPill does not change.
+//
+//go:generate go-enumerator --compat=enumer --json
+type Pill int
+
+const (
+	Placebo Pill = iota
+	Aspirin
+	Ibuprofen
+	Paracetamol
+)
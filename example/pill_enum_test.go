@@ -0,0 +1,52 @@
+package example
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestPill(t *testing.T) {
+	pills := [4]Pill{
+		Placebo, Aspirin, Ibuprofen, Paracetamol,
+	}
+
+	tests := []test[*Pill, string]{
+		{&pills[0], "Placebo", new(Pill)},
+		{&pills[1], "Aspirin", new(Pill)},
+		{&pills[2], "Ibuprofen", new(Pill)},
+		{&pills[3], "Paracetamol", new(Pill)},
+	}
+
+	doTest(t, tests, func() *Pill {
+		ret := new(Pill)
+		*ret = 4
+		return ret
+	})
+}
+
+func TestPillEnumer(t *testing.T) {
+	p, err := PillString("Ibuprofen")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if p != Ibuprofen {
+		t.Errorf("PillString(%q) = %v, want = %v", "Ibuprofen", p, Ibuprofen)
+	}
+
+	if _, err := PillString("Acetaminophen"); err == nil {
+		t.Errorf("PillString(%q) returned no error", "Acetaminophen")
+	}
+
+	if !Aspirin.IsAPill() {
+		t.Errorf("Aspirin.IsAPill() = false, want = true")
+	}
+
+	if Pill(4).IsAPill() {
+		t.Errorf("Pill(4).IsAPill() = true, want = false")
+	}
+
+	if got, want := PillValues(), []Pill{Placebo, Aspirin, Ibuprofen, Paracetamol}; !slices.Equal(got, want) {
+		t.Errorf("PillValues() = %v, want = %v", got, want)
+	}
+}
@@ -0,0 +1,14 @@
+package example
+
+// Planet and DwarfPlanet demonstrate types whose declarations are split across files.
+// Passing both files to --input generates code for the types of either file.
+//
+//go:generate go-enumerator --all-types --input=planet.go,planet_outer.go
+type Planet int
+
+const (
+	Mercury Planet = iota
+	Venus
+	Earth
+	Mars
+)
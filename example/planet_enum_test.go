@@ -0,0 +1,45 @@
+package example
+
+import (
+	"testing"
+)
+
+func TestPlanet(t *testing.T) {
+	planets := [3]Planet{
+		Mercury, Mars, Jupiter,
+	}
+
+	tests := []test[*Planet, string]{
+		{&planets[0], "Mercury", new(Planet)},
+		{&planets[1], "Mars", new(Planet)},
+		{&planets[2], "Jupiter", new(Planet)},
+	}
+
+	doTest(t, tests, func() *Planet {
+		ret := new(Planet)
+		*ret = 9
+		return ret
+	})
+
+	if got, want := len(PlanetValues()), 8; got != want {
+		t.Errorf("len(PlanetValues()) = %d, want = %d", got, want)
+	}
+}
+
+func TestDwarfPlanet(t *testing.T) {
+	dwarfPlanets := [3]DwarfPlanet{
+		Ceres, Pluto, Eris,
+	}
+
+	tests := []test[*DwarfPlanet, string]{
+		{&dwarfPlanets[0], "Ceres", new(DwarfPlanet)},
+		{&dwarfPlanets[1], "Pluto", new(DwarfPlanet)},
+		{&dwarfPlanets[2], "Eris", new(DwarfPlanet)},
+	}
+
+	doTest(t, tests, func() *DwarfPlanet {
+		ret := new(DwarfPlanet)
+		*ret = 9
+		return ret
+	})
+}
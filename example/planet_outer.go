@@ -0,0 +1,16 @@
+package example
+
+const (
+	Jupiter Planet = iota + 4
+	Saturn
+	Uranus
+	Neptune
+)
+
+type DwarfPlanet int
+
+const (
+	Ceres DwarfPlanet = iota
+	Pluto
+	Eris
+)
@@ -0,0 +1,27 @@
+package example
+
+import (
+	"testing"
+)
+
+func TestPort(t *testing.T) {
+	ports := [3]Port{
+		PortHTTP, PortHTTPS, PortAlt,
+	}
+
+	tests := []test[*Port, string]{
+		{&ports[0], "PortHTTP", new(Port)},
+		{&ports[1], "PortHTTPS", new(Port)},
+		{&ports[2], "PortAlt", new(Port)},
+	}
+
+	doTest(t, tests, func() *Port {
+		ret := new(Port)
+		*ret = 22
+		return ret
+	})
+
+	if got, want := Port(22).String(), "Port(22)"; got != want {
+		t.Errorf("String() = %v, want = %v", got, want)
+	}
+}
@@ -0,0 +1,15 @@
+package example
+
+// Priority demonstrates enums declared with a type alias. The code is generated
+// for priority, the type it is an alias of, so its methods can be used on either.
+//
+//go:generate go-enumerator
+type Priority = priority
+
+type priority int
+
+const (
+	PriorityLow Priority = iota
+	PriorityNormal
+	PriorityHigh
+)
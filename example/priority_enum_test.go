@@ -0,0 +1,23 @@
+package example
+
+import (
+	"testing"
+)
+
+func TestPriority(t *testing.T) {
+	priorities := [3]Priority{
+		PriorityLow, PriorityNormal, PriorityHigh,
+	}
+
+	tests := []test[*Priority, string]{
+		{&priorities[0], "PriorityLow", new(Priority)},
+		{&priorities[1], "PriorityNormal", new(Priority)},
+		{&priorities[2], "PriorityHigh", new(Priority)},
+	}
+
+	doTest(t, tests, func() *Priority {
+		ret := new(Priority)
+		*ret = 9
+		return ret
+	})
+}
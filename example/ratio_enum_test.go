@@ -0,0 +1,28 @@
+package example
+
+import (
+	"testing"
+)
+
+func TestRatio(t *testing.T) {
+	ratios := [4]Ratio{
+		RatioQuarter, RatioThird, RatioHalf, RatioWhole,
+	}
+
+	tests := []test[*Ratio, string]{
+		{&ratios[0], "RatioQuarter", new(Ratio)},
+		{&ratios[1], "RatioThird", new(Ratio)},
+		{&ratios[2], "RatioHalf", new(Ratio)},
+		{&ratios[3], "RatioWhole", new(Ratio)},
+	}
+
+	doTest(t, tests, func() *Ratio {
+		ret := new(Ratio)
+		*ret = 0.75
+		return ret
+	})
+
+	if got, want := Ratio(0.75).String(), "Ratio(0.75)"; got != want {
+		t.Errorf("String() = %v, want = %v", got, want)
+	}
+}
@@ -0,0 +1,52 @@
+package example
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+)
+
+func TestRegion(t *testing.T) {
+	regions := [3]Region{
+		RegionNorthAmerica, RegionEurope, RegionAsiaPacific,
+	}
+
+	tests := []test[*Region, string]{
+		{&regions[0], "north-america", new(Region)},
+		{&regions[1], "europe", new(Region)},
+		{&regions[2], "asia-pacific", new(Region)},
+	}
+
+	doTest(t, tests, func() *Region {
+		ret := new(Region)
+		*ret = "antarctica"
+		return ret
+	})
+
+	r := Region("antarctica")
+	if err := r.Validate(); err == nil || err.Error() != "invalid Region: antarctica" {
+		t.Errorf("Validate() = %v, want = %v", err, "invalid Region: antarctica")
+	}
+
+	// only pointers implement fmt.Stringer
+	var _ fmt.Stringer = &r
+}
+
+func TestSortRegions(t *testing.T) {
+	// undefined values are sorted after the defined values, by their underlying values
+	regions := []Region{"oceania", RegionAsiaPacific, RegionNorthAmerica, "africa", RegionEurope}
+	SortRegions(regions)
+
+	want := []Region{RegionNorthAmerica, RegionEurope, RegionAsiaPacific, "africa", "oceania"}
+	if !slices.Equal(regions, want) {
+		t.Errorf("SortRegions() = %v, want = %v", regions, want)
+	}
+
+	if !RegionEurope.Less(RegionAsiaPacific) {
+		t.Errorf("RegionEurope.Less(RegionAsiaPacific) = false, want = true")
+	}
+
+	if RegionAsiaPacific.Less(RegionEurope) {
+		t.Errorf("RegionAsiaPacific.Less(RegionEurope) = true, want = false")
+	}
+}
@@ -0,0 +1,81 @@
+package example
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+func TestRoleSet(t *testing.T) {
+	roles := RoleSet{}
+	roles.Add(RoleAdmin, RoleViewer, Role(42))
+
+	if !roles.Contains(RoleAdmin) || roles.Contains(RoleEditor) {
+		t.Errorf("Contains() returned unexpected results for %v", roles)
+	}
+
+	if got, want := roles.String(), "RoleViewer, RoleAdmin, Role(42)"; got != want {
+		t.Errorf("String() = %v, want = %v", got, want)
+	}
+
+	roles.Remove(Role(42))
+	b, err := json.Marshal(roles.Slice())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := string(b), `["RoleViewer","RoleAdmin"]`; got != want {
+		t.Errorf("json.Marshal() = %v, want = %v", got, want)
+	}
+
+	var decoded []Role
+	if err := json.Unmarshal(b, &decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	got := RoleSet{}
+	got.Add(decoded...)
+	if got.String() != roles.String() {
+		t.Errorf("round trip = %v, want = %v", got, roles)
+	}
+}
+
+func TestRoleFormat(t *testing.T) {
+	tests := []struct {
+		format string
+		value  Role
+		want   string
+	}{
+		{"%v", RoleAdmin, "RoleAdmin"},
+		{"%s", RoleAdmin, "RoleAdmin"},
+		{"%q", RoleAdmin, `"RoleAdmin"`},
+		{"%-12v|", RoleAdmin, "RoleAdmin   |"},
+		{"%#v", RoleAdmin, "example.RoleAdmin"},
+		{"%#v", Role(42), "example.Role(42)"},
+		{"%+v", RoleAdmin, "RoleAdmin(3)"},
+		{"%+v", Role(42), "Role(42)"},
+		{"%d", RoleAdmin, "3"},
+		{"%03d", RoleAdmin, "003"},
+	}
+
+	for _, tt := range tests {
+		if got := fmt.Sprintf(tt.format, tt.value); got != tt.want {
+			t.Errorf("Sprintf(%q) = %v, want = %v", tt.format, got, tt.want)
+		}
+	}
+}
+
+func TestRoleExcluded(t *testing.T) {
+	if RoleUnknown.Defined() {
+		t.Errorf("RoleUnknown.Defined() = true, want = false")
+	}
+
+	if got, want := RoleUnknown.String(), "Role(0)"; got != want {
+		t.Errorf("String() = %v, want = %v", got, want)
+	}
+
+	var r Role
+	if err := r.UnmarshalText([]byte("RoleUnknown")); err == nil {
+		t.Errorf("UnmarshalText() expected error")
+	}
+}
@@ -0,0 +1,12 @@
+package example
+
+// Severity demonstrates failing to marshal undefined values instead of encoding their fallback string.
+//
+//go:generate go-enumerator --json --marshal-strict --trim-prefix=Severity --naming-strategy=snake_case
+type Severity uint8
+
+const (
+	SeverityInfo Severity = iota + 1
+	SeverityWarning
+	SeverityCritical
+)
@@ -0,0 +1,29 @@
+package example
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestSeverityMarshalStrict(t *testing.T) {
+	got, err := json.Marshal(map[string]Severity{"level": SeverityWarning})
+	if want := `{"level":"warning"}`; err != nil || string(got) != want {
+		t.Errorf("json.Marshal() = %s, %v, want = %s, nil", got, err, want)
+	}
+
+	if got, err := SeverityCritical.MarshalText(); err != nil || string(got) != "critical" {
+		t.Errorf("MarshalText() = %q, %v, want = %q, nil", got, err, "critical")
+	}
+
+	// the zero value isn't defined, so it can't be written by mistake
+	for _, s := range []Severity{0, 4} {
+		if got, err := s.MarshalText(); err == nil || !strings.Contains(err.Error(), "undefined Severity") {
+			t.Errorf("%d.MarshalText() = %q, %v, want error", uint8(s), got, err)
+		}
+
+		if got, err := json.Marshal(struct{ Level Severity }{s}); err == nil {
+			t.Errorf("json.Marshal(%d) = %s, want error", uint8(s), got)
+		}
+	}
+}
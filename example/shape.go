@@ -0,0 +1,21 @@
+package example
+
+//go:generate go-enumerator --all-types
+
+// Shape demonstrates generating multiple enums from a single go:generate directive
+type Shape int
+
+const (
+	Circle Shape = iota
+	Square
+	Triangle
+)
+
+// Size demonstrates generating multiple enums from a single go:generate directive
+type Size string
+
+const (
+	Small  Size = "S"
+	Medium Size = "M"
+	Large  Size = "L"
+)
@@ -0,0 +1,41 @@
+package example
+
+import (
+	"testing"
+)
+
+func TestShape(t *testing.T) {
+	shapes := [3]Shape{
+		Circle, Square, Triangle,
+	}
+
+	tests := []test[*Shape, string]{
+		{&shapes[0], "Circle", new(Shape)},
+		{&shapes[1], "Square", new(Shape)},
+		{&shapes[2], "Triangle", new(Shape)},
+	}
+
+	doTest(t, tests, func() *Shape {
+		ret := new(Shape)
+		*ret = -1
+		return ret
+	})
+}
+
+func TestSize(t *testing.T) {
+	sizes := [3]Size{
+		Small, Medium, Large,
+	}
+
+	tests := []test[*Size, string]{
+		{&sizes[0], "S", new(Size)},
+		{&sizes[1], "M", new(Size)},
+		{&sizes[2], "L", new(Size)},
+	}
+
+	doTest(t, tests, func() *Size {
+		ret := new(Size)
+		*ret = "XL"
+		return ret
+	})
+}
@@ -0,0 +1,15 @@
+//go:build unix
+
+package example
+
+// Signal demonstrates enums that are only declared on some platforms.
+// The build constraint of this file is copied to the generated file.
+//
+//go:generate go-enumerator
+type Signal int
+
+const (
+	SignalHangup    Signal = 1
+	SignalInterrupt Signal = 2
+	SignalTerminate Signal = 15
+)
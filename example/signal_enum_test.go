@@ -0,0 +1,25 @@
+//go:build unix
+
+package example
+
+import (
+	"testing"
+)
+
+func TestSignal(t *testing.T) {
+	signals := [3]Signal{
+		SignalHangup, SignalInterrupt, SignalTerminate,
+	}
+
+	tests := []test[*Signal, string]{
+		{&signals[0], "SignalHangup", new(Signal)},
+		{&signals[1], "SignalInterrupt", new(Signal)},
+		{&signals[2], "SignalTerminate", new(Signal)},
+	}
+
+	doTest(t, tests, func() *Signal {
+		ret := new(Signal)
+		*ret = 9
+		return ret
+	})
+}
@@ -0,0 +1,100 @@
+package example
+
+import (
+	"testing"
+)
+
+func TestStatusString(t *testing.T) {
+	tests := []struct {
+		sut  Status
+		want string
+	}{
+		{StatusActive, "Active"},
+		{StatusInactive, "Inactive"},
+		{StatusDeleted, "Removed"},
+	}
+
+	for _, test := range tests {
+		if got := test.sut.String(); got != test.want {
+			t.Errorf("String() = %v, want = %v", got, test.want)
+		}
+	}
+}
+
+func TestStatusSQL(t *testing.T) {
+	for _, want := range []Status{StatusActive, StatusInactive, StatusDeleted} {
+		v, err := want.Value()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if v != int64(want) {
+			t.Errorf("Value() = %v, want = %v", v, int64(want))
+		}
+
+		for _, src := range []any{v, want.String(), []byte(want.String())} {
+			var got Status
+			if err := got.Scan(src); err != nil {
+				t.Errorf("Scan(%#v) returned error: %v", src, err)
+			}
+
+			if got != want {
+				t.Errorf("Scan(%#v) = %v, want = %v", src, got, want)
+			}
+		}
+	}
+
+	for _, src := range []any{int64(0), "bogus", []byte("bogus"), 1.5, nil} {
+		var got Status
+		if err := got.Scan(src); err == nil {
+			t.Errorf("Scan(%#v) = %v, want error", src, got)
+		}
+	}
+}
+
+func TestStatusDescription(t *testing.T) {
+	tests := []struct {
+		sut  Status
+		want string
+	}{
+		{StatusActive, "The account can be used"},
+		{StatusInactive, "The account is temporarily disabled"},
+		{StatusDeleted, "The account has been deleted"},
+		{Status(0), ""},
+	}
+
+	for _, test := range tests {
+		if got := test.sut.Description(); got != test.want {
+			t.Errorf("Description() = %v, want = %v", got, test.want)
+		}
+	}
+}
+
+func TestStatusAcceptNumeric(t *testing.T) {
+	tests := []struct {
+		input string
+		want  Status
+	}{
+		{"Active", StatusActive},
+		{"1", StatusActive},
+		{"Removed", StatusDeleted},
+		{"4", StatusDeleted},
+	}
+
+	for _, tt := range tests {
+		var got Status
+		if err := got.UnmarshalText([]byte(tt.input)); err != nil {
+			t.Errorf("UnmarshalText(%q) = %v", tt.input, err)
+		} else if got != tt.want {
+			t.Errorf("UnmarshalText(%q) = %v, want = %v", tt.input, got, tt.want)
+		}
+	}
+
+	// numbers must be defined values, and the skipped value 3 is not
+	for _, input := range []string{"3", "0", "-1", "1.0", "99999999999999999999"} {
+		var got Status
+		if err := got.UnmarshalText([]byte(input)); err == nil {
+			t.Errorf("UnmarshalText(%q) = %v, want error", input, got)
+		}
+	}
+}
@@ -0,0 +1,13 @@
+package example
+
+// Style demonstrates bit flags with generated StyleNone and StyleAll constants
+// and a Split method, so that they don't have to be maintained by hand
+//
+//go:generate go-enumerator --flags --flags-helpers --trim-prefix=Style
+type Style uint8
+
+const (
+	StyleBold Style = 1 << iota
+	StyleItalic
+	StyleUnderline
+)
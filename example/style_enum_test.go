@@ -0,0 +1,57 @@
+package example
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestStyleHelpers(t *testing.T) {
+	if got, want := StyleAll, StyleBold|StyleItalic|StyleUnderline; got != want {
+		t.Errorf("StyleAll = %v, want = %v", got, want)
+	}
+
+	if !StyleNone.Defined() {
+		t.Errorf("StyleNone.Defined() = false, want = true")
+	}
+
+	tests := []struct {
+		style Style
+		want  []Style
+	}{
+		{StyleNone, nil},
+		{StyleItalic, []Style{StyleItalic}},
+		{StyleBold | StyleUnderline, []Style{StyleBold, StyleUnderline}},
+		{StyleAll, []Style{StyleBold, StyleItalic, StyleUnderline}},
+		{StyleItalic | 1<<7, []Style{StyleItalic}},
+	}
+
+	for _, tt := range tests {
+		if got := tt.style.Split(); !slices.Equal(got, tt.want) {
+			t.Errorf("%v.Split() = %v, want = %v", tt.style, got, tt.want)
+		}
+	}
+}
+
+func TestStyleNone(t *testing.T) {
+	if err := StyleNone.Validate(); err != nil {
+		t.Errorf("StyleNone.Validate() = %v, want nil", err)
+	}
+
+	b, err := StyleNone.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := string(b), ""; got != want {
+		t.Errorf("StyleNone.MarshalText() = %q, want = %q", got, want)
+	}
+
+	got := StyleAll
+	if err := got.UnmarshalText(b); err != nil {
+		t.Fatal(err)
+	}
+
+	if got != StyleNone {
+		t.Errorf("UnmarshalText() = %v, want = %v", got, StyleNone)
+	}
+}
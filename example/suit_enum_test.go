@@ -0,0 +1,44 @@
+package example
+
+import (
+	"testing"
+)
+
+func TestSuit(t *testing.T) {
+	suits := [4]Suit{
+		SuitSpades, SuitHearts, SuitDiamonds, SuitClubs,
+	}
+
+	tests := []test[*Suit, string]{
+		{&suits[0], "♠", new(Suit)},
+		{&suits[1], "♥", new(Suit)},
+		{&suits[2], "SuitDiamonds", new(Suit)},
+		{&suits[3], "SuitClubs", new(Suit)},
+	}
+
+	doTest(t, tests, func() *Suit {
+		ret := new(Suit)
+		*ret = '★'
+		return ret
+	})
+
+	if got, want := Suit('★').String(), "Suit('★')"; got != want {
+		t.Errorf("String() = %v, want = %v", got, want)
+	}
+
+	if got, want := string(Suit('★').Bytes()), "Suit('★')"; got != want {
+		t.Errorf("Bytes() = %v, want = %v", got, want)
+	}
+}
+
+func TestSuitValues(t *testing.T) {
+	// the length of the array is a constant, so it can be used for other arrays
+	var strs [len(SuitValues)]string
+	for i, s := range SuitValues {
+		strs[i] = s.String()
+	}
+
+	if want := [...]string{"♠", "♥", "SuitDiamonds", "SuitClubs"}; strs != want {
+		t.Errorf("SuitValues = %v, want = %v", strs, want)
+	}
+}
@@ -0,0 +1,12 @@
+package example
+
+// Tier demonstrates a small integer enum that is stored in a database as a bigger integer.
+//
+//go:generate go-enumerator --sql
+type Tier uint8
+
+const (
+	TierFree Tier = iota + 1
+	TierPro
+	TierEnterprise
+)
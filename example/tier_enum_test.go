@@ -0,0 +1,18 @@
+package example
+
+import "testing"
+
+func TestTierScan(t *testing.T) {
+	var got Tier
+	if err := got.Scan(int64(2)); err != nil || got != TierPro {
+		t.Errorf("Scan(int64(2)) = %v, %v, want = %v, nil", got, err, TierPro)
+	}
+
+	// 257 and -255 would be truncated to TierFree
+	for _, src := range []int64{0, 4, 257, -255, 1 << 40} {
+		var got Tier
+		if err := got.Scan(src); err == nil {
+			t.Errorf("Scan(int64(%d)) = %v, want error", src, got)
+		}
+	}
+}
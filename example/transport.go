@@ -0,0 +1,22 @@
+package example
+
+// Method demonstrates generating multiple enums into a single file
+//
+//go:generate go-enumerator --output=zz_generated.go --append
+type Method int
+
+const (
+	MethodGet    Method = iota // GET
+	MethodPost                 // POST
+	MethodDelete               // DELETE
+)
+
+// Scheme demonstrates generating multiple enums into a single file
+//
+//go:generate go-enumerator --output=zz_generated.go --append --json
+type Scheme string
+
+const (
+	SchemeHTTP  Scheme = "http"
+	SchemeHTTPS Scheme = "https"
+)
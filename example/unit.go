@@ -0,0 +1,17 @@
+package example
+
+// Unit demonstrates selecting constants with a marker, so that constants that
+// are not part of the enum can be declared in the same block.
+//
+//go:generate go-enumerator --only-marked
+type Unit int64
+
+const (
+	Byte     Unit = 1       // enum B
+	Kilobyte Unit = 1 << 10 // enum KiB
+	Megabyte Unit = 1 << 20 // enum MiB
+	Gigabyte Unit = 1 << 30 // enum
+
+	// DefaultUnit is not marked, so it is not an alias of Kilobyte.
+	DefaultUnit = Kilobyte
+)
@@ -0,0 +1,24 @@
+package example
+
+import (
+	"testing"
+)
+
+func TestUnit(t *testing.T) {
+	units := [4]Unit{
+		Byte, Kilobyte, Megabyte, Gigabyte,
+	}
+
+	tests := []test[*Unit, string]{
+		{&units[0], "B", new(Unit)},
+		{&units[1], "KiB", new(Unit)},
+		{&units[2], "MiB", new(Unit)},
+		{&units[3], "Gigabyte", new(Unit)},
+	}
+
+	doTest(t, tests, func() *Unit {
+		ret := new(Unit)
+		*ret = 3
+		return ret
+	})
+}
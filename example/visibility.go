@@ -0,0 +1,13 @@
+package example
+
+// Visibility demonstrates an explicit empty string representation, which is
+// used by VisibilityNone so that unset values are encoded as empty strings.
+//
+//go:generate go-enumerator --json --emit-debug
+type Visibility int
+
+const (
+	VisibilityNone    Visibility = iota // ""
+	VisibilityPublic                    // public
+	VisibilityPrivate                   // private
+)
@@ -0,0 +1,51 @@
+package example
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestVisibility(t *testing.T) {
+	visibilities := [3]Visibility{
+		VisibilityNone, VisibilityPublic, VisibilityPrivate,
+	}
+
+	tests := []test[*Visibility, string]{
+		{&visibilities[0], "", new(Visibility)},
+		{&visibilities[1], "public", new(Visibility)},
+		{&visibilities[2], "private", new(Visibility)},
+	}
+
+	doTest(t, tests, func() *Visibility {
+		ret := new(Visibility)
+		*ret = 3
+		return ret
+	})
+}
+
+func TestVisibilityJSON(t *testing.T) {
+	b, err := json.Marshal(VisibilityNone)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := string(b), `""`; got != want {
+		t.Errorf("json.Marshal(VisibilityNone) = %s, want = %s", got, want)
+	}
+
+	v := VisibilityPrivate
+	if err := json.Unmarshal([]byte(`""`), &v); err != nil {
+		t.Fatal(err)
+	}
+
+	if v != VisibilityNone {
+		t.Errorf("json.Unmarshal(%q) = %v, want = %v", `""`, v, VisibilityNone)
+	}
+}
+
+func TestVisibilityDebugString(t *testing.T) {
+	want := "VisibilityNone=(0)\nVisibilityPublic=public(1)\nVisibilityPrivate=private(2)"
+	if got := VisibilityDebugString(); got != want {
+		t.Errorf("VisibilityDebugString() = %q, want = %q", got, want)
+	}
+}
@@ -0,0 +1,50 @@
+package example
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestMethod(t *testing.T) {
+	methods := [3]Method{
+		MethodGet, MethodPost, MethodDelete,
+	}
+
+	tests := []test[*Method, string]{
+		{&methods[0], "GET", new(Method)},
+		{&methods[1], "POST", new(Method)},
+		{&methods[2], "DELETE", new(Method)},
+	}
+
+	doTest(t, tests, func() *Method {
+		ret := new(Method)
+		*ret = 42
+		return ret
+	})
+}
+
+func TestScheme(t *testing.T) {
+	schemes := [2]Scheme{
+		SchemeHTTP, SchemeHTTPS,
+	}
+
+	tests := []test[*Scheme, string]{
+		{&schemes[0], "http", new(Scheme)},
+		{&schemes[1], "https", new(Scheme)},
+	}
+
+	doTest(t, tests, func() *Scheme {
+		ret := new(Scheme)
+		*ret = "ftp"
+		return ret
+	})
+
+	b, err := json.Marshal(SchemeHTTPS)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := string(b), `"https"`; got != want {
+		t.Errorf("json.Marshal() = %v, want = %v", got, want)
+	}
+}
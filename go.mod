@@ -6,10 +6,12 @@ toolchain go1.23.1
 
 require (
 	github.com/dave/jennifer v1.7.1
+	github.com/pelletier/go-toml/v2 v2.2.3
 	github.com/spf13/cobra v1.8.1
 	github.com/spf13/pflag v1.0.5
 	github.com/stoewer/go-strcase v1.3.0
 	golang.org/x/tools v0.25.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
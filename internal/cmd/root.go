@@ -1,25 +1,22 @@
 package cmd
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
-	"go/ast"
-	"go/constant"
+	"go/parser"
 	"go/token"
-	"go/types"
-	"math"
+	"io"
 	"os"
-	"sort"
+	"path/filepath"
+	"slices"
 	"strings"
-	"unicode"
-	"unicode/utf8"
 
-	"github.com/dave/jennifer/jen"
+	"github.com/a-jentleman/go-enumerator/pkg/enumgen"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
-	"github.com/stoewer/go-strcase"
-	"golang.org/x/tools/go/ast/astutil"
-	"golang.org/x/tools/go/packages"
+	"golang.org/x/tools/imports"
+	"gopkg.in/yaml.v3"
 )
 
 func Execute() {
@@ -29,17 +26,6 @@ func Execute() {
 	}
 }
 
-type namingStrategyName string
-
-const (
-	none           namingStrategyName = "none"
-	camelCase      namingStrategyName = "camelCase"
-	pascalCase     namingStrategyName = "PascalCase"
-	snakeCase      namingStrategyName = "snake_case"
-	upperSnakeCase namingStrategyName = "UPPER_SNAKE_CASE"
-	kebabCase      namingStrategyName = "kebab-case"
-)
-
 // rootCmd represents the base command when called without any subcommands
 var rootCmd = &cobra.Command{
 	Use:   "go-enumerator",
@@ -50,722 +36,818 @@ go-enumerator is designed to be called by go generate. See https://pkg.go.dev/gi
 	RunE: func(cmd *cobra.Command, _ []string) error {
 		cmd.RegisterFlagCompletionFunc("naming-strategy", func(_ *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 			var ret []string
-
-			if strings.HasPrefix(string(none), toComplete) {
-				ret = append(ret, string(none))
+			for _, s := range enumgen.NamingStrategies() {
+				if strings.HasPrefix(s, toComplete) {
+					ret = append(ret, s)
+				}
 			}
 
-			if strings.HasPrefix(string(camelCase), toComplete) {
-				ret = append(ret, string(camelCase))
-			}
+			return ret, cobra.ShellCompDirectiveNoFileComp | cobra.ShellCompDirectiveKeepOrder
+		})
 
-			if strings.HasPrefix(string(pascalCase), toComplete) {
-				ret = append(ret, string(pascalCase))
+		// with --dir, the whole package is loaded instead of the package of an input file,
+		// so the variables set by go generate for the directive's file are ignored
+		dir := flagDir
+		if dir != "" && cmd.Flag("input").Changed {
+			return errors.New("--input cannot be used with --dir")
+		}
+
+		// explicitly empty values are honored, but an empty input file or package can't be loaded
+		var input string
+		var inputFileNames []string
+		if dir == "" {
+			var ok bool
+			input, ok = resolveParameterValue(cmd.Flag("input"), "GOFILE")
+			if !ok {
+				return errors.New("failed to determine input file")
 			}
-
-			if strings.HasPrefix(string(snakeCase), toComplete) {
-				ret = append(ret, string(snakeCase))
+			if input == "" {
+				return errors.New("failed to determine input file: --input or $GOFILE is empty")
 			}
 
-			if strings.HasPrefix(string(upperSnakeCase), toComplete) {
-				ret = append(ret, string(upperSnakeCase))
+			inputFileNames = strings.Split(input, ",")
+			if slices.Contains(inputFileNames, "") {
+				return fmt.Errorf("invalid --input %q: file names must not be empty", input)
 			}
-
-			if strings.HasPrefix(string(kebabCase), toComplete) {
-				ret = append(ret, string(kebabCase))
+			if len(inputFileNames) > 1 && slices.ContainsFunc(inputFileNames, isStdin) {
+				return fmt.Errorf("invalid --input %q: standard input cannot be combined with other files", input)
 			}
+		}
 
-			return ret, cobra.ShellCompDirectiveNoFileComp | cobra.ShellCompDirectiveKeepOrder
-		})
+		// the package name is optional with --dir, since the directory holds a single package
+		var pkgName string
+		if dir == "" {
+			var ok bool
+			pkgName, ok = resolveParameterValue(cmd.Flag("pkg"), "GOPACKAGE")
+			if !ok {
+				return errors.New("failed to determine package name")
+			}
+			if pkgName == "" {
+				return errors.New("failed to determine package name: --pkg or $GOPACKAGE is empty")
+			}
+		} else if cmd.Flag("pkg").Changed {
+			if flagPkg == "" {
+				return errors.New("failed to determine package name: --pkg is empty")
+			}
+			pkgName = flagPkg
+		}
 
-		inputFileName, ok := resolveParameterValue(cmd.Flag("input"), "GOFILE")
-		if !ok {
-			return errors.New("failed to determine input file")
+		reproInput := input
+		var overlay map[string][]byte
+		if isStdin(input) {
+			var err error
+			inputFileNames[0], overlay, err = readStdinOverlay()
+			if err != nil {
+				return err
+			}
 		}
 
-		pkgName, ok := resolveParameterValue(cmd.Flag("pkg"), "GOPACKAGE")
-		if !ok {
-			return errors.New("failed to determine package name")
+		// flags given in a config file apply to the rest of the options.
+		// The go:generate directive is in the first input file
+		configDir := dir
+		if configDir == "" {
+			configDir = filepath.Dir(inputFileNames[0])
 		}
 
-		pkg, err := loadPackage(pkgName, inputFileName)
+		configFileName, err := findConfigFile(configDir)
 		if err != nil {
 			return err
 		}
 
+		if configFileName != "" {
+			if err := applyConfigFile(cmd.Flags(), configFileName); err != nil {
+				return err
+			}
+		}
+
+		if flagQuiet && flagVerbose {
+			return errors.New("--quiet cannot be used with --verbose")
+		}
+
+		if configFileName != "" {
+			verbosef("using config file %s", configFileName)
+		}
+
 		typeName, _ := resolveParameterValue(cmd.Flag("type"), "")
 
+		if dir != "" && cmd.Flag("line").Changed {
+			return errors.New("--line cannot be used with --dir, since there is no input file to resolve the position in")
+		}
+
 		var line int
 		lineStr, _ := resolveParameterValue(cmd.Flag("line"), "GOLINE")
-		if lineStr != "" {
+		if lineStr != "" && dir == "" {
 			_, err = fmt.Sscan(lineStr, &line)
 			if err != nil {
 				return fmt.Errorf("failed to determine source line: %w", err)
 			}
 		}
 
-		tn, err := findTypeDecl(pkg.Fset, pkg.TypesInfo, typeName, inputFileName, line)
-		if err != nil {
-			return err
+		if flagDryRun && flagCheck {
+			return errors.New("--dry-run cannot be used with --check")
 		}
 
-		// update typeName if it was not specified by the caller, but we found it in the source code
-		if typeName == "" && tn.Name() != "" {
-			typeName = tn.Name()
+		if flagAppend {
+			switch {
+			case flagEmitJSON:
+				return errors.New("--append cannot be used with --emit-json")
+			case flagEmitTest:
+				return errors.New("--append cannot be used with --emit-test")
+			case flagEmitBench:
+				return errors.New("--append cannot be used with --emit-bench")
+			}
 		}
 
-		receiver, _ := resolveParameterValue(cmd.Flag("receiver"), "")
-		if receiver == "" {
-			receiver = defaultReceiverName(tn)
+		banner := strings.TrimSpace(strings.TrimPrefix(flagBanner, "//"))
+		if banner == "" {
+			return errors.New("--banner must be a single line of text")
 		}
-		receiver = safeIndent(receiver)
 
-		reproCmd := os.Args[0]
-		if inputFileName != "" {
-			reproCmd = fmt.Sprintf("%s --input=%q", reproCmd, inputFileName)
+		outputFileName, outputSpecified := resolveParameterValue(cmd.Flag("output"), "")
+		if outputSpecified && outputFileName == "" {
+			return errors.New("--output must not be empty")
 		}
 
-		if pkgName != "" {
-			reproCmd = fmt.Sprintf("%s --pkg=%q", reproCmd, pkgName)
+		if outputSpecified && flagAllTypes {
+			return errors.New("--output cannot be used with --all-types")
 		}
 
-		if line > 0 {
-			reproCmd = fmt.Sprintf("%s --line=%d", reproCmd, line)
+		if outputSpecified && flagOutputTemplate != "" {
+			return errors.New("--output cannot be used with --output-template")
 		}
 
-		vs, kind := findConstantsOfType(pkg.Fset, pkg.TypesInfo, pkg.Syntax, tn, namingStrategyName(flagNameFunc))
-		if len(vs) == 0 {
-			return fmt.Errorf("no constants of type %q found", tn.Name())
+		if flagCompileLimit <= 0 {
+			return fmt.Errorf("invalid --compile-check-limit %d: must be positive", flagCompileLimit)
 		}
 
-		f, err := generateEnumCode(pkgName, tn, vs, kind, receiver, reproCmd)
+		if cmd.Flag("naming-exec").Changed && flagNamingExec == "" {
+			return errors.New("--naming-exec must not be empty")
+		}
+
+		header, err := readHeader(flagHeaderFile, flagHeader)
 		if err != nil {
 			return err
 		}
 
-		outputFileName, ok := resolveParameterValue(cmd.Flag("output"), "")
-		if !ok {
-			outputFileName = fmt.Sprintf("%s_enum.go", unexportedName(typeName))
-		}
+		receiver, _ := resolveParameterValue(cmd.Flag("receiver"), "")
 
-		out, cleanup, err := openOutputFile(outputFileName)
+		reproCmd := os.Args[0]
+		if reproInput != "" {
+			reproCmd = fmt.Sprintf("%s --input=%q", reproCmd, reproInput)
+		}
+
+		opts := enumgen.Options{
+			Dir:     dir,
+			Files:   inputFileNames,
+			Package: pkgName,
+			Type:    typeName,
+			Line:    line,
+			Tags:    flagTags,
+			Overlay: overlay,
+
+			AllTypes: flagAllTypes,
+			Group:    flagGroup,
+
+			Receiver:       receiver,
+			ReceiverStyle:  flagReceiverStyle,
+			NamingStrategy: flagNameFunc,
+			NamingExec:     flagNamingExec,
+			StringFormat:   flagStringFormat,
+			TrimPrefix:     flagTrimPrefix,
+			AutoTrimPrefix: flagAutoTrimPrefix,
+			Prefix:         flagPrefix,
+			CommentTag:     flagCommentTag,
+			Descriptions:   flagDescriptions,
+			Exclude:        flagExclude,
+			OnlyMarked:     flagOnlyMarked,
+			CheckBlanks:    flagCheckBlanks,
+			AllowAliases:   flagAllowAliases,
+			Strict:         flagStrict,
+
+			OutputPkg:      flagOutputPkg,
+			OutputTemplate: flagOutputTemplate,
+			GoVersion:      flagGoVersion,
+			Banner:         banner,
+			Header:         header,
+			Command:        reproCmd,
+
+			JSON:            flagJSON,
+			MarshalStrict:   flagMarshalStrict,
+			GQLGen:          flagGQLGen,
+			SQL:             flagSQL,
+			Slog:            flagSlog,
+			Binary:          flagBinary,
+			YAML:            flagYAML,
+			Msgpack:         flagMsgpack,
+			XML:             flagXML,
+			XMLAttr:         flagXMLAttr,
+			Formatter:       flagFormatter,
+			CaseInsensitive: flagCaseInsensitive,
+			TrimSpace:       flagTrimSpace,
+			Flags:           flagFlags,
+			FlagsHelpers:    flagFlagsHelpers,
+			SentinelError:   flagSentinelError,
+			ReceiverPointer: flagReceiverPointer,
+			Set:             flagSet,
+			MinMax:          flagMinMax,
+			StringerStyle:   flagStringerStyle,
+			UnknownFormat:   &flagUnknownFormat,
+			ProtoMaps:       flagProtoMaps,
+			Lookup:          flagLookup,
+			ValuesStyle:     flagValuesStyle,
+			Scan:            flagScan,
+			Compat:          flagCompat,
+			AcceptNumeric:   flagAcceptNumeric,
+			Functions:       flagFunctions,
+			NoCompileCheck:  flagNoCompileCheck,
+			StrictCases:     flagStrictCases,
+
+			CompileCheckLimit: flagCompileLimit,
+
+			EmitJSON:       flagEmitJSON,
+			EmitTest:       flagEmitTest,
+			EmitBench:      flagEmitBench,
+			EmitRandom:     flagEmitRandom,
+			EmitSort:       flagEmitSort,
+			EmitDebug:      flagEmitDebug,
+			EmitPredicates: flagEmitPredicates,
+			EmitIter:       flagEmitIter,
+
+			Verbose: flagVerbose,
+		}
+
+		if !flagQuiet {
+			opts.Log = os.Stderr
+		}
+
+		enums, err := enumgen.GenerateEnums(opts)
 		if err != nil {
 			return err
 		}
-		defer cleanup()
 
-		return f.Render(out)
+		if len(enums) == 0 {
+			return fmt.Errorf("no types with constants found in %s", reproInput)
+		}
+
+		// the generated file can't be placed next to the type if it belongs to another package
+		if flagOutputPkg != "" && flagOutputPkg != enums[0].Package && !outputSpecified {
+			return errors.New("--output must be specified with --output-pkg")
+		}
+
+		for _, e := range enums {
+			name := e.FileName
+			if outputSpecified {
+				name = outputFileName
+			}
+
+			if flagAppend {
+				err = appendOutputFile(e.Code, name, e.Type, banner)
+			} else {
+				err = writeOutput(name, e.Code)
+			}
+			if err != nil {
+				return err
+			}
+
+			if e.Test != nil {
+				if err := writeOutput(testFileName(name), e.Test); err != nil {
+					return err
+				}
+			}
+
+			if e.Bench != nil {
+				if err := writeOutput(benchFileName(name), e.Bench); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
 	},
 	Example: "go-enumerator --input example.go --output kind_enum.go --pkg example --type Kind --receiver k",
 }
 
 func init() {
 	fs := rootCmd.Flags()
-	fs.StringVarP(&flagInput, "input", "i", "", "input file to scan. If not specified, input defaults to the value of $GOFILE, which is set by go generate")
-	fs.StringVarP(&flagOutput, "output", "o", "", "output file to create. If not specified, output defaults to the value of <type>_enum.go. As special cases, you can specify <STDOUT> or <STDERR> to output to standard output or standard error")
+	fs.StringVarP(&flagInput, "input", "i", "", "input file to scan, or a comma-separated list of files for types whose declarations are split across files. --line refers to the first file. If not specified, input defaults to the value of $GOFILE, which is set by go generate. As special cases, you can specify - or <STDIN> to read from standard input, in which case the current directory is used as the package directory")
+	fs.StringVarP(&flagOutput, "output", "o", "", "output file to create. If not specified, output defaults to the value of <type>_enum.go, or the name produced by --output-template. As special cases, you can specify <STDOUT> or <STDERR> to output to standard output or standard error")
+	fs.StringVar(&flagDir, "dir", "", "directory of the package to load instead of the package of the input file. This requires --type, and cannot be used with --input, --line or --all-types. Unless --output is given, the output file is created in this directory")
+	fs.StringVar(&flagTags, "tags", "", "comma-separated list of build tags to consider satisfied when loading the package, as with go build -tags")
+	fs.StringVar(&flagOutputTemplate, "output-template", "", "text/template for the name of the output file when --output is not specified, such as {{.Type | lower}}_generated.go. .Type and .Package are available, along with the lower, snake and unexported functions. If not specified, {{.Type | unexported}}_enum.go is used")
 	fs.StringVarP(&flagPkg, "pkg", "p", "", "package name for the generated file. If not specified, pkg defaults to the value of $GOPACKAGE which is set by go generate")
 	fs.StringVarP(&flagType, "type", "t", "", "type name to generate an enum definition for. If not specified, it attempts to find the type using $GOLINE and $GOFILE")
-	fs.StringVarP(&flagReceiver, "receiver", "r", "", "receiver variable name of the generated methods. By default, the first letter of the type if used")
+	fs.StringVarP(&flagReceiver, "receiver", "r", "", "receiver variable name of the generated methods. By default, it is derived from the type according to --receiver-style. Names that would shadow identifiers used by the generated code, such as err or fmt, are prefixed with an underscore")
+	fs.StringVar(&flagReceiverStyle, "receiver-style", "initial", "how the default receiver name is derived from the type when --receiver is not given. Valid choices are: initial (the first letter, Kind becomes k), short (the first two letters, Kind becomes ki) and acronym (the first letter of each word, HTTPStatus becomes hs)")
+	fs.BoolVar(&flagReceiverPointer, "receiver-pointer", false, "use pointer receivers for the String, Bytes, Defined, Next and Prev methods, which avoids copying large values. Only pointers implement fmt.Stringer, so values are no longer formatted using String by the fmt package")
 	fs.IntVarP(&flagLine, "line", "l", 0, "Specify the line to search for types from if a type name is not specified. If not specified, line defaults to the value of $GOLINE which is set by go generate.")
-	fs.StringVarP(&flagNameFunc, "naming-strategy", "n", "none", "Specify a naming strategy to use. Valid choices are: none, camelCase, PascalCase, snake_case, UPPER_SNAKE_CASE, and kebab-case. The naming strategy will be used when generating names for enum values. This strategy is ignored for values that have a name override specified as a line comment.")
+	fs.StringVar(&flagNamingExec, "naming-exec", "", "command that converts constant names to string representations, for naming rules that --naming-strategy doesn't support. It runs once with the names, after --trim-prefix is applied, written to its standard input one per line, and must write the string representation of each name to its standard output in the same order. --prefix is added to the result. Arguments are separated by spaces. Cannot be used with --naming-strategy")
+	fs.StringVarP(&flagNameFunc, "naming-strategy", "n", "none", "Specify a naming strategy to use. Valid choices are: none, camelCase, PascalCase, snake_case, UPPER_SNAKE_CASE, kebab-case, \"Title Case\", and \"Sentence case\". The last two separate words with spaces and keep acronyms in upper case. The naming strategy will be used when generating names for enum values. This strategy is ignored for values that have a name override specified as a line comment.")
+	fs.StringVar(&flagStringFormat, "string-format", "", "fmt verb that produces the string representations of integer enums from their underlying values instead of their names, such as %03d for codes like \"007\". Line comment overrides still take precedence, and --prefix is added to the result. Cannot be used with --naming-strategy, --naming-exec, --trim-prefix or --auto-trim-prefix")
+	fs.BoolVar(&flagAutoTrimPrefix, "auto-trim-prefix", false, "remove the longest prefix of whole words shared by the names of all constants of the type before the naming strategy is applied, such as Color in ColorRed and ColorGreen. Cannot be used with --trim-prefix")
+	fs.StringVar(&flagTrimPrefix, "trim-prefix", "", "prefix to remove from constant names before the naming strategy is applied. This is ignored for values that have a name override specified as a line comment.")
+	fs.StringVar(&flagCommentTag, "comment-tag", "enum", "key used to override string representations in line comments written with struct tag syntax, such as enum:\"active\" desc:\"The active state\". The desc key generates a Description method. Line comments without tags override the string representation as a whole")
+	fs.StringSliceVar(&flagExclude, "exclude", nil, "names of constants to leave out of the enum, such as sentinel values. Excluded values are formatted like undefined values, but they are still part of the compile check. Can be repeated")
+	fs.BoolVar(&flagDescriptions, "descriptions", false, "use the doc comments of constants as their descriptions, generating a Description method. Descriptions given with desc in line comments take precedence")
+	fs.StringVar(&flagPrefix, "prefix", "", "prefix to add to string representations after the naming strategy is applied. This is ignored for values that have a name override specified as a line comment.")
+	fs.BoolVar(&flagCaseInsensitive, "case-insensitive", false, "parse strings into values regardless of their case. It is an error if two values have string representations that only differ by case")
+	fs.BoolVar(&flagTrimSpace, "trim-space", false, "remove leading and trailing spaces from strings before parsing them, for data such as CSV files or config values with stray spaces. A warning is printed if a string representation starts or ends with spaces, since it could no longer be parsed")
+	fs.BoolVar(&flagJSON, "json", false, "generate MarshalJSON and UnmarshalJSON methods. The values are encoded as JSON strings using their string representation")
+	fs.BoolVar(&flagMarshalStrict, "marshal-strict", false, "make MarshalText, AppendText and MarshalJSON return an error for values that are not defined, instead of encoding their fallback string representation, so that invalid values can't be serialized unnoticed")
+	fs.BoolVar(&flagGQLGen, "gqlgen", false, "generate MarshalGQL and UnmarshalGQL methods implementing the graphql.Marshaler and graphql.Unmarshaler interfaces of gqlgen, so that the type can be bound to a GraphQL enum. The values are encoded as GraphQL strings using their string representation")
+	fs.StringVar(&flagYAML, "yaml", "", "generate MarshalYAML and UnmarshalYAML methods for the given major version of the yaml package. Valid choices are: v2 (gopkg.in/yaml.v2) and v3 (gopkg.in/yaml.v3)")
+	fs.StringVar(&flagMsgpack, "msgpack", "", "generate MarshalMsgpack and UnmarshalMsgpack methods implementing msgpack.Marshaler and msgpack.Unmarshaler of github.com/vmihailenco/msgpack, without importing it. The values are encoded as msgpack strings using their string representation, or as msgpack integers using their underlying value with --msgpack=int, which requires an integer enum")
+	fs.Lookup("msgpack").NoOptDefVal = "string"
+	fs.BoolVar(&flagXML, "xml", false, "generate MarshalXML and UnmarshalXML methods implementing xml.Marshaler and xml.Unmarshaler. The values are encoded as element text using their string representation")
+	fs.BoolVar(&flagXMLAttr, "xml-attr", false, "also generate MarshalXMLAttr and UnmarshalXMLAttr methods so that values can be used as XML attributes. Requires --xml")
+	fs.BoolVar(&flagSQL, "sql", false, "generate Value and Scan methods implementing driver.Valuer and sql.Scanner. Since a type can only have one Scan method, the fmt.Scanner implementation is not generated when this flag is set")
+	fs.BoolVar(&flagBinary, "binary", false, "generate MarshalBinary and UnmarshalBinary methods implementing encoding.BinaryMarshaler and encoding.BinaryUnmarshaler. Numeric values are encoded in big endian using the size of the underlying type; string values use their string representation")
+	fs.BoolVar(&flagSlog, "slog", false, "generate a LogValue method implementing slog.LogValuer (requires Go 1.21 or later)")
+	fs.BoolVar(&flagOnlyMarked, "only-marked", false, "only include constants whose line comment starts with the --comment-tag key, such as // enum, so that other constants of the type can be declared alongside them. The rest of the comment overrides the string representation as usual, such as // enum active or // enum:\"active\"")
+	fs.BoolVar(&flagProtoMaps, "proto-maps", false, "generate <type>NameMap and <type>ValueMap variables that map int32 values to string representations and back, like the <Enum>_name and <Enum>_value maps of protobuf enums. Requires an integer enum whose values fit in an int32")
+	fs.BoolVar(&flagStringerStyle, "stringer-style", false, "generate a String method that slices the string representation out of one string using a table of indexes, like the stringer tool, instead of using a switch. It is only used for integer enums whose values are 0 to n-1. Other enums use a switch")
+	fs.BoolVar(&flagMinMax, "min-max", false, "generate <type>Min and <type>Max constants set to the defined constants with the smallest and largest values, for range validation. They are not generated for string enums")
+	fs.BoolVar(&flagSet, "set", false, "generate a <type>Set type for collections of values, with Add, Remove, Contains, Slice and String methods")
+	fs.BoolVar(&flagFormatter, "formatter", false, "generate a Format method implementing fmt.Formatter (requires Go 1.20 or later). %q quotes the string representation, %#v prints the name of the constant and %+v adds the underlying value. Other verbs format the underlying value, so %d no longer calls String")
+	fs.StringVar(&flagHeaderFile, "header-file", "", "file whose contents are added as comments to the top of generated files, such as a license or copyright notice")
+	fs.StringVar(&flagBanner, "banner", enumgen.DefaultBanner, "the \"Code generated\" line at the top of generated files, which is also used to recognize files generated by go-enumerator when appending. Tools only treat files as generated if it matches \"Code generated ... DO NOT EDIT.\"")
+	fs.StringVar(&flagGoVersion, "go-version", "", "the Go version that the generated code must compile with, such as 1.21. Methods for interfaces added in later versions, such as AppendText, are not generated, and flags that require them fail. Defaults to the go version of the module. If it is later than that, a //go:build constraint for it is added to the generated files")
+	fs.BoolVarP(&flagQuiet, "quiet", "q", false, "only print errors, not warnings")
+	fs.BoolVarP(&flagVerbose, "verbose", "v", false, "print the package, types and constants that were found, and the files that were written. This helps to diagnose why constants weren't found")
+	fs.StringArrayVar(&flagHeader, "header", nil, "line to add as a comment to the top of generated files, after the contents of --header-file. Can be repeated")
+	fs.BoolVar(&flagFlagsHelpers, "flags-helpers", false, "also generate <type>None and <type>All constants, holding no flags and every defined flag, and a Split method that returns the single flags that are set. Requires --flags")
+	fs.BoolVar(&flagSentinelError, "sentinel-error", false, "return a preallocated Err<type> variable from Scan, UnmarshalText and Parse<type> when a string is not a defined value, instead of allocating an *Invalid<type>Error. This avoids allocations when parsing untrusted input at high volume, at the cost of not reporting the string")
+	fs.BoolVar(&flagFlags, "flags", false, "treat the values as bit flags that can be combined. String joins the names of the set flags with \"|\", parsing accepts the same format, and Has, Set and Clear methods are generated")
+	fs.BoolVar(&flagStrictCases, "strict-cases", false, "also generate a _() function with a switch that lists every value. Linters that check switches for missing cases, such as exhaustive, then report constants that were added without regenerating")
+	fs.BoolVar(&flagNoCompileCheck, "no-compile-check", false, "do not generate the _() function that fails to compile if the constant values change after the code was generated")
+	fs.IntVar(&flagCompileLimit, "compile-check-limit", enumgen.DefaultCompileCheckLimit, "total length in bytes of the values of a string enum above which the compile check compares each value as a whole instead of indexing it byte by byte, which keeps the generated function small and compiles quickly for long values")
+	fs.StringVar(&flagValuesStyle, "values-style", "func", "how the defined values are listed. Valid choices are: func and array. func generates a <type>Values function that returns a new slice. array generates a <type>Values array variable instead, whose length is a constant, at the cost of allowing callers to modify it")
+	fs.StringVar(&flagUnknownFormat, "unknown-format", enumgen.DefaultUnknownFormat, "text/template for the string representation of undefined values, such as %!{{.Type}}({{.Value}}). .Type is the name of the type and .Value is its underlying value. An empty template formats undefined values as the empty string. String enums ignore this flag")
+	fs.StringVar(&flagCompat, "compat", "", "also generate the symbols of another enum generator, so that it can be replaced without changing the code that uses them. Valid choices are: enumer, which adds a <type>String function that parses strings like Parse<type>, and an IsA<type> method like Defined")
+	fs.BoolVar(&flagAcceptNumeric, "accept-numeric", false, "also accept the underlying values of integer enums written as integers, such as \"0\", in UnmarshalText and Parse<type>, as long as they are defined. String representations take precedence. Other enums ignore this flag")
+	fs.StringVar(&flagLookup, "lookup", "switch", "how strings are looked up when parsing. Valid choices are: switch and map. map generates a package-level map and a Parse<type> function, which can be faster for enums with many values")
+	fs.BoolVar(&flagStrict, "strict", false, "fail instead of silently choosing a behavior or printing a warning when the input is ambiguous: string representations that can't be parsed back, empty line comment overrides, constants whose file can't be found and types with the same name that --line can't choose between")
+	fs.StringVar(&flagScan, "scan", "token", "how Scan reads values. Valid choices are: token and values. token reads up to the next space. values reads the longest input that starts a defined string representation, so string representations that contain spaces can be scanned")
+	fs.BoolVar(&flagAllowAliases, "allow-aliases", false, "allow multiple constants with the same value. The first declared constant is used when formatting a value, but the names of all of them can be parsed")
+	fs.BoolVar(&flagCheckBlanks, "check-blanks", false, "also check the values skipped by constants declared with the blank identifier. Generation fails if a skipped value is used by a named constant, and the skipped values are listed in the compile check so that changes to them show up when regenerating")
+	fs.BoolVar(&flagEmitJSON, "emit-json", false, "write a JSON description of the enum instead of Go code, with the type, its underlying type and the name, string representation and value of each constant in declaration order. If --output is not specified, the file is named like the Go file, with a .json extension")
+	fs.BoolVar(&flagEmitRandom, "emit-random", false, "also generate a <type>Random function that returns a uniformly random defined value using a *rand.Rand from math/rand, for fuzz and property tests")
+	fs.BoolVar(&flagEmitDebug, "emit-debug", false, "also generate a <type>DebugString function that returns a line for each constant with its name, string representation and underlying value, such as Kind1=Kind1(0), for logging the enum at startup")
+	fs.BoolVar(&flagEmitIter, "emit-iter", false, "also generate a <type>All function returning an iter.Seq over the defined values in the order they are declared, so that they can be looped through with for v := range KindAll(). Requires Go 1.23 or later, and cannot be used with --flags-helpers, which declares a <type>All constant")
+	fs.BoolVar(&flagEmitPredicates, "emit-predicates", false, "also generate an Is<name> method for each constant that reports whether the value is that constant, such as IsActive for StatusActive. The name of the type is removed from the start of the constant name if the rest starts a new word")
+	fs.BoolVar(&flagEmitSort, "emit-sort", false, "also generate a Less method and a Sort<type>s function that order values by their declaration instead of their underlying values")
+	fs.BoolVar(&flagEmitBench, "emit-bench", false, "also generate a <type>_enum_bench_test.go file with benchmarks for String, MarshalText, UnmarshalText and Parse<type> that cycle through every value")
+	fs.BoolVar(&flagEmitTest, "emit-test", false, "also generate a <type>_enum_test.go file that checks that every value round-trips through its string representation")
+	fs.BoolVar(&flagAllTypes, "all-types", false, "generate enum definitions for every type declared in the input file that has constants. Each type is written to its own <type>_enum.go file")
+	fs.StringVar(&flagGroup, "group", "", "generate the code for the constants of the const block after the directive, which are untyped or of a basic type rather than of a named type, such as const (Red = \"red\"; Green = \"green\"). A type alias with this name is declared for their type, such as type Color = string, and used by the generated functions. Requires --functions")
+	fs.BoolVar(&flagFunctions, "functions", false, "generate functions that take the enum as their first parameter instead of methods, such as KindString(k Kind) instead of k.String(). Only the String, Bytes, Defined, Validate, Next and Prev functions are generated, along with a Parse function")
+	fs.StringVar(&flagOutputPkg, "output-pkg", "", "package name of the generated file if it should be in a different package than the type. Since methods cannot be declared outside of a type's package, this requires --functions and --output")
+	fs.BoolVar(&flagAppend, "append", false, "add the generated code to the output file instead of replacing it, so that several enums can share one file. If the file was generated by go-enumerator, only the code between the begin and end markers of the type is replaced. The code of other types is kept")
+	fs.BoolVar(&flagDryRun, "dry-run", false, "write the generated code to standard output instead of the output file, preceded by a comment with the name of the file that would have been written")
+	fs.BoolVar(&flagCheck, "check", false, "check that the output file is up to date instead of writing it. If the file is missing or differs from what would be generated, a message is printed and the exit code is non-zero")
 	_ = fs.MarkHidden("line")
 }
 
 var (
-	flagInput    string
-	flagOutput   string
-	flagPkg      string
-	flagType     string
-	flagReceiver string
-	flagLine     int
-	flagNameFunc string
+	flagInput           string
+	flagOutput          string
+	flagPkg             string
+	flagType            string
+	flagReceiver        string
+	flagReceiverStyle   string
+	flagLine            int
+	flagNameFunc        string
+	flagNamingExec      string
+	flagStringFormat    string
+	flagTrimPrefix      string
+	flagAutoTrimPrefix  bool
+	flagPrefix          string
+	flagCommentTag      string
+	flagDescriptions    bool
+	flagExclude         []string
+	flagEmitJSON        bool
+	flagJSON            bool
+	flagMarshalStrict   bool
+	flagGQLGen          bool
+	flagYAML            string
+	flagMsgpack         string
+	flagXML             bool
+	flagXMLAttr         bool
+	flagSQL             bool
+	flagSlog            bool
+	flagBinary          bool
+	flagFormatter       bool
+	flagCheck           bool
+	flagDryRun          bool
+	flagAppend          bool
+	flagAllTypes        bool
+	flagFlags           bool
+	flagFlagsHelpers    bool
+	flagSentinelError   bool
+	flagNoCompileCheck  bool
+	flagCompileLimit    int
+	flagStrictCases     bool
+	flagBanner          string
+	flagGoVersion       string
+	flagLookup          string
+	flagAcceptNumeric   bool
+	flagUnknownFormat   string
+	flagCompat          string
+	flagValuesStyle     string
+	flagScan            string
+	flagStrict          bool
+	flagQuiet           bool
+	flagVerbose         bool
+	flagAllowAliases    bool
+	flagCheckBlanks     bool
+	flagEmitTest        bool
+	flagEmitBench       bool
+	flagEmitRandom      bool
+	flagEmitSort        bool
+	flagEmitDebug       bool
+	flagEmitPredicates  bool
+	flagEmitIter        bool
+	flagReceiverPointer bool
+	flagSet             bool
+	flagMinMax          bool
+	flagStringerStyle   bool
+	flagOnlyMarked      bool
+	flagProtoMaps       bool
+	flagTags            string
+	flagDir             string
+	flagHeaderFile      string
+	flagOutputTemplate  string
+	flagHeader          []string
+	flagFunctions       bool
+	flagGroup           string
+	flagOutputPkg       string
+	flagCaseInsensitive bool
+	flagTrimSpace       bool
 )
 
 // resolveParameterValue returns the parameter value from f if it was specified
 // by the user. Otherwise, if env is not empty, it looks up the value from the
-// environment variable named env.
+// environment variable named env. The boolean reports whether the value was given
+// either way, so an explicitly empty value, such as --line= or GOLINE="", is returned
+// as ("", true) and is not replaced by the environment variable or the default.
 func resolveParameterValue(f *pflag.Flag, env string) (string, bool) {
 	if f.Changed {
 		return f.Value.String(), true
 	}
 
 	if env != "" {
-		return os.LookupEnv(env)
+		if v, ok := os.LookupEnv(env); ok {
+			return v, true
+		}
 	}
 
 	return f.DefValue, false
 }
 
-// loadPackage loads the package of file inputFileName.
-func loadPackage(pkgName, inputFileName string) (*packages.Package, error) {
-	pkgs, err := packages.Load(&packages.Config{
-		Mode: packages.NeedName |
-			packages.NeedTypes |
-			packages.NeedTypesInfo |
-			packages.NeedDeps |
-			packages.NeedSyntax |
-			packages.NeedImports},
-		fmt.Sprintf("file=%s", inputFileName))
+// configFileBaseName is the name of the file that sets default values of flags.
+// It is looked up in the directory of the input file and its parents.
+const configFileBaseName = ".go-enumerator.yaml"
+
+// configExcludedFlags are the flags that can't be set in a config file, since they identify
+// what is generated by a single go:generate directive.
+var configExcludedFlags = []string{"input", "pkg", "type", "line", "output", "all-types", "dir", "help"}
+
+// findConfigFile returns the name of the config file closest to dir, which is either in dir
+// or one of its parents. If there is none, an empty string is returned.
+func findConfigFile(dir string) (string, error) {
+	dir, err := filepath.Abs(dir)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 
-	var ret *packages.Package
-	for _, pkg := range pkgs {
-		if pkg.Name != pkgName {
-			continue
+	for {
+		name := filepath.Join(dir, configFileBaseName)
+		if _, err := os.Stat(name); err == nil {
+			return name, nil
+		} else if !errors.Is(err, os.ErrNotExist) {
+			return "", err
 		}
 
-		if ret != nil {
-			return nil, fmt.Errorf("multiple packages found with name %s", pkgName)
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", nil
 		}
-
-		ret = pkg
-	}
-
-	if ret == nil {
-		return nil, fmt.Errorf("no packages found with name %s", pkgName)
+		dir = parent
 	}
-
-	return ret, nil
 }
 
-// findTypeDecl find the relevant *types.TypeName from fset & info.
-// If name is passed, a type with that name is searched for.
-// Otherwise, the first type after line in inputFileName is returned.
-// If the next declaration after line in inputFileName is not a *types.TypeName,
-// an error is returned.
-func findTypeDecl(fset *token.FileSet, info *types.Info, name, inputFileName string, line int) (*types.TypeName, error) {
-	if name != "" {
-		return findTypeDeclByName(info, name)
+// applyConfigFile sets the flags of fs to the values given in the YAML config file name, which maps
+// flag names to values, such as naming-strategy: snake_case. Lists set flags that can be repeated.
+// Flags that were given on the command line are left alone, so that they take precedence.
+func applyConfigFile(fs *pflag.FlagSet, name string) error {
+	b, err := os.ReadFile(name)
+	if err != nil {
+		return fmt.Errorf("failed to read config file: %w", err)
 	}
 
-	return findTypeDeclByPosition(fset, info, inputFileName, line)
-}
-
-// findTypeDeclByPosition finds the next *type.TypeName in inputFileName after line
-func findTypeDeclByPosition(fset *token.FileSet, info *types.Info, inputFileName string, line int) (*types.TypeName, error) {
-	var ret *types.TypeName
-	var closestObject types.Object
-	closest := math.MaxInt32
-	for _, object := range info.Defs {
-		if object == nil {
-			continue
-		}
-
-		p := fset.Position(object.Pos())
-		if !sameFile(p.Filename, inputFileName) {
-			continue
-		}
+	var config map[string]any
+	if err := yaml.Unmarshal(b, &config); err != nil {
+		return fmt.Errorf("failed to parse config file %s: %w", name, err)
+	}
 
-		if p.Line < line || closest < p.Line {
+	keys := make([]string, 0, len(config))
+	for key := range config {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		f := fs.Lookup(key)
+		switch {
+		case f == nil:
+			return fmt.Errorf("%s: unknown flag %q", name, key)
+		case slices.Contains(configExcludedFlags, key):
+			return fmt.Errorf("%s: --%s can't be set in a config file, since it differs between go:generate directives", name, key)
+		case f.Changed:
 			continue
 		}
 
-		ret = nil // we found something closer than our current closest thing
-		closestObject = object
-
-		c, ok := object.(*types.TypeName)
+		values, ok := config[key].([]any)
 		if !ok {
-			continue
+			values = []any{config[key]}
 		}
 
-		ret = c
-		closest = p.Line
-	}
+		for _, v := range values {
+			switch v.(type) {
+			case nil:
+				return fmt.Errorf("%s: missing value for %s", name, key)
+			case map[string]any, []any:
+				return fmt.Errorf("%s: invalid value for %s: must be a string, number or boolean, or a list of them", name, key)
+			}
 
-	if ret == nil {
-		if closestObject != nil {
-			return nil, fmt.Errorf("failed to determine type: closest declaration is not a named type: %v", closestObject)
+			if err := fs.Set(key, fmt.Sprint(v)); err != nil {
+				return fmt.Errorf("%s: invalid value for %s: %w", name, key, err)
+			}
 		}
-		return nil, fmt.Errorf("failed to determine type")
 	}
 
-	return ret, nil
+	return nil
 }
 
-// findTypeDeclByName finds the the *types.TypeName in info named name.
-func findTypeDeclByName(info *types.Info, name string) (*types.TypeName, error) {
-	for _, object := range info.Defs {
-		if object == nil {
-			continue
-		}
-
-		c, ok := object.(*types.TypeName)
-		if !ok {
-			continue
-		}
+// stdinFileName is the name given to source read from standard input.
+// It is placed in the working directory so the rest of the package can be loaded with it.
+const stdinFileName = "go_enumerator_stdin.go"
 
-		if c.Name() != name {
-			continue
-		}
+// isStdin returns true if name requests that input is read from standard input.
+func isStdin(name string) bool {
+	return name == "-" || name == "<STDIN>"
+}
 
-		return c, nil
+// readStdinOverlay reads Go source from standard input and returns the synthetic file name
+// it should be loaded as, along with the overlay that provides its contents to packages.Load.
+func readStdinOverlay() (string, map[string][]byte, error) {
+	src, err := io.ReadAll(os.Stdin)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to read standard input: %w", err)
 	}
 
-	return nil, fmt.Errorf("type %q not found", name)
-}
+	wd, err := os.Getwd()
+	if err != nil {
+		return "", nil, err
+	}
 
-type constNameAndString struct {
-	Const  *types.Const
-	Name   string
-	String string
+	name := filepath.Join(wd, stdinFileName)
+	return name, map[string][]byte{name: src}, nil
 }
 
-// findConstantsOfType finds all constants in info that are of type obj.
-func findConstantsOfType(fset *token.FileSet, info *types.Info, syntax []*ast.File, obj types.Object, namingStrategy namingStrategyName) ([]constNameAndString, constant.Kind) {
-	var ret []constNameAndString
-	kind := constant.Unknown
-	for _, object := range info.Defs {
-		if object == nil {
-			continue
-		}
-
-		c, ok := object.(*types.Const)
-		if !ok {
-			continue
-		}
-
-		t, ok := c.Type().(*types.Named)
-		if !ok {
-			continue
-		}
-
-		if c.Name() == "_" {
-			continue
-		}
-
-		if t.Obj() != obj {
-			continue
-		}
-
-		k := c.Val().Kind()
-		if kind == constant.Unknown {
-			kind = k
-		}
-
-		if kind != k {
-			panic("multiple constant kinds found")
-		}
-
-		name := c.Name()
-		astFile := findAstFileForToken(c.Pos(), syntax)
-		nodes, _ := astutil.PathEnclosingInterval(astFile, c.Pos(), c.Pos())
-		str := findStringInLineComment(c.Pos(), nodes, astFile, fset)
-		if str == "" {
-			switch namingStrategy {
-			case camelCase:
-				str = strcase.LowerCamelCase(name)
-			case pascalCase:
-				str = strcase.UpperCamelCase(name)
-			case snakeCase:
-				str = strcase.SnakeCase(name)
-			case upperSnakeCase:
-				str = strcase.UpperSnakeCase(name)
-			case kebabCase:
-				str = strcase.KebabCase(name)
-			default:
-				str = name
-			}
-		}
-
-		cn := constNameAndString{
-			Const:  c,
-			Name:   name,
-			String: str,
+// readHeader returns the lines to add to the top of generated files.
+// The contents of the file headerFile, if any, come first, followed by the lines in header.
+func readHeader(headerFile string, header []string) ([]string, error) {
+	var lines []string
+	if headerFile != "" {
+		b, err := os.ReadFile(headerFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read header file: %w", err)
 		}
 
-		ret = append(ret, cn)
+		lines = strings.Split(strings.TrimRight(strings.ReplaceAll(string(b), "\r\n", "\n"), "\n"), "\n")
 	}
+	return append(lines, header...), nil
+}
 
-	if len(ret) == 0 {
-		return nil, constant.Unknown
+// testFileName returns the name of the test file generated alongside the output file name.
+// Special names like <STDOUT> are returned as is. Other names get the _test.go suffix even if they
+// don't end with .go, so that the test file doesn't overwrite the output file.
+func testFileName(name string) string {
+	switch name {
+	case "<STDOUT>", "<STDERR>":
+		return name
 	}
 
-	// Sort the items based on where they show up in source code.
-	// This is mainly to avoid significant differences in version control overtime.
-	sort.Slice(ret, func(i, j int) bool {
-		ip := fset.Position(ret[i].Const.Pos())
-		jp := fset.Position(ret[j].Const.Pos())
-
-		return ip.Filename < jp.Filename ||
-			ip.Filename == jp.Filename && ip.Offset < jp.Offset
-	})
-
-	return ret, kind
+	return strings.TrimSuffix(name, ".go") + "_test.go"
 }
 
-func findAstFileForToken(pos token.Pos, syntax []*ast.File) *ast.File {
-	for _, file := range syntax {
-		if pos < file.FileStart {
-			continue
-		}
-		if pos > file.FileEnd {
-			continue
-		}
-		return file
+// benchFileName returns the name of the benchmark file generated alongside the output file name.
+// Like testFileName, only special names are returned as is.
+func benchFileName(name string) string {
+	switch name {
+	case "<STDOUT>", "<STDERR>":
+		return name
 	}
-	return nil
-}
-
-func findStringInLineComment(pos token.Pos, nodes []ast.Node, astFile *ast.File, tokenFile *token.FileSet) string {
-	for _, node := range nodes {
-		gd, ok := node.(*ast.GenDecl)
-		if !ok {
-			continue
-		}
 
-		for _, cg := range astFile.Comments {
-			cgPos := cg.Pos()
-			if cgPos < gd.Pos() {
-				continue
-			}
-
-			cgPosition := tokenFile.Position(cgPos)
-			position := tokenFile.Position(pos)
-			if cgPosition.Line != position.Line {
-				continue
-			}
+	return strings.TrimSuffix(name, ".go") + "_bench_test.go"
+}
 
-			return strings.TrimSpace(cg.Text())
-		}
+// appendOutputFile merges src into the file name as the region of typeName
+// using mergeGeneratedRegion, then writes it using writeOutput.
+func appendOutputFile(src []byte, name, typeName, banner string) error {
+	switch name {
+	case "<STDOUT>", "<STDERR>":
+		return fmt.Errorf("--append cannot be used to write to %s", name)
 	}
-	return ""
-}
 
-// sameFile determines if a and b point to the same file
-func sameFile(a, b string) bool {
-	as, err := os.Stat(a)
-	if err != nil {
-		panic(err)
+	existing, err := os.ReadFile(name)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
 	}
 
-	bs, err := os.Stat(b)
+	merged, err := mergeGeneratedRegion(name, existing, src, typeName, banner)
 	if err != nil {
-		panic(err)
+		return err
 	}
 
-	return os.SameFile(as, bs)
+	return writeOutput(name, merged)
 }
 
-// generateEnumCode generates the code to turn tn into an enum
-func generateEnumCode(pkgName string, tn *types.TypeName, cs []constNameAndString, kind constant.Kind, receiver string, reproCmd string) (f *jen.File, err error) {
-	defer func() {
-		if r := recover(); r != nil {
-			f = nil
-			err = r.(error)
-		}
-	}()
-
-	tokenVarName := safeIndent("token", receiver)
-	stringVarName := safeIndent("str", receiver, tokenVarName)
-	scanStateVarName := safeIndent("scanState", receiver, tokenVarName, stringVarName)
-	verbVarName := safeIndent("verb", receiver, tokenVarName, stringVarName, scanStateVarName)
-	xVarName := safeIndent("x", receiver, tokenVarName, stringVarName, scanStateVarName, verbVarName)
+// generatedRegion is the code generated for one type in a file written with --append.
+type generatedRegion struct {
+	typeName string
+	text     string // the code between the begin and end markers, including them
+}
 
-	anyOverrides := false
-	uniqueStrings := make(map[string]bool, len(cs))
-	uniqueNames := make(map[string]bool, len(cs))
-	uniqueValues := make(map[string]bool, len(cs))
+// regionBegin and regionEnd return the markers around the code generated for typeName in a file written with --append.
+func regionBegin(typeName string) string { return "// go-enumerator:begin " + typeName }
+func regionEnd(typeName string) string   { return "// go-enumerator:end " + typeName }
+
+// mergeGeneratedRegion returns the contents of the file name after adding src, the code generated for typeName,
+// to existing, its current contents. existing may be nil if the file does not exist yet.
+// The code of each type is kept between begin and end markers. If existing already has
+// the markers of typeName, the code between them is replaced. Otherwise, it is added to the end.
+// The imports of the file are merged, and the header of src is used for the file, except for the
+// line with the command that generated it, which is moved inside of the region.
+// existing must contain banner, so that files that weren't generated by go-enumerator aren't modified.
+func mergeGeneratedRegion(name string, existing, src []byte, typeName, banner string) ([]byte, error) {
+	header, pkgName, importSpecs, body, err := splitGeneratedFile(name, src)
+	if err != nil {
+		return nil, err
+	}
 
-	for _, c := range cs {
-		if c.String != c.Name {
-			anyOverrides = true
+	var command string
+	var headerLines []string
+	for _, line := range strings.Split(header, "\n") {
+		if strings.HasPrefix(line, "// Command: ") {
+			command = line
+			continue
 		}
+		headerLines = append(headerLines, line)
+	}
 
-		str := c.String
-		name := c.Name
-		repr := c.Const.Val().ExactString()
-
-		if uniqueStrings[str] {
-			return nil, fmt.Errorf("duplicate string found: %q", c.String)
-		}
+	region := generatedRegion{typeName: typeName}
+	region.text = regionBegin(typeName) + "\n"
+	if command != "" {
+		region.text += command + "\n"
+	}
+	region.text += "\n" + strings.TrimSpace(body) + "\n\n" + regionEnd(typeName)
 
-		if uniqueNames[name] {
-			return nil, fmt.Errorf("duplicate name found: %q", name)
+	var regions []generatedRegion
+	if existing != nil {
+		if !bytes.Contains(existing, []byte(banner)) {
+			return nil, fmt.Errorf("cannot append to %s: it was not generated by go-enumerator", name)
 		}
 
-		if uniqueNames[str] {
-			return nil, fmt.Errorf("string collides with existing name: %q", c.String)
+		_, _, existingImports, existingBody, err := splitGeneratedFile(name, existing)
+		if err != nil {
+			return nil, err
 		}
 
-		if uniqueValues[repr] {
-			return nil, fmt.Errorf("duplicate value found: %s", repr)
+		importSpecs = append(existingImports, importSpecs...)
+		regions, err = findGeneratedRegions(existingBody)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
 		}
-
-		uniqueStrings[str] = true
-		uniqueNames[name] = true
-		uniqueValues[repr] = true
 	}
 
-	f = jen.NewFile(pkgName)
-	f.HeaderComment("Code generated by go-enumerator; DO NOT EDIT.")
-	f.HeaderComment("Command: " + reproCmd)
-
-	f.Line()
-	generateStringMethod(f, receiver, kind, tn, cs, anyOverrides)
-
-	f.Line()
-	generateBytesMethod(f, receiver, kind, tn, cs, anyOverrides)
-
-	f.Line()
-	generateDefinedMethod(f, receiver, tn, cs)
-
-	f.Line()
-	generateScanMethod(f, tn, receiver, scanStateVarName, verbVarName, tokenVarName, cs)
-
-	f.Line()
-	generateNextMethod(f, tn, receiver, cs, kind)
-
-	f.Line()
-	generateCompileCheckFunction(f, xVarName, cs, kind)
-
-	f.Line()
-	generateTextMarshal(f, receiver, tn)
-
-	f.Line()
-	generateTextUnmarshal(f, receiver, tn, cs, xVarName)
+	i := slices.IndexFunc(regions, func(r generatedRegion) bool { return r.typeName == typeName })
+	if i >= 0 {
+		regions[i] = region
+	} else {
+		regions = append(regions, region)
+	}
 
-	f.Line()
-	generateTypeAssertions(f, tn, kind)
+	var buf bytes.Buffer
+	buf.WriteString(strings.TrimSpace(strings.Join(headerLines, "\n")))
+	fmt.Fprintf(&buf, "\n\npackage %s\n\n", pkgName)
 
-	f.Line()
+	// unused imports are removed by imports.Process
+	slices.Sort(importSpecs)
+	importSpecs = slices.Compact(importSpecs)
+	if len(importSpecs) > 0 {
+		fmt.Fprintf(&buf, "import (\n%s\n)\n\n", strings.Join(importSpecs, "\n"))
+	}
 
-	return f, nil
-}
+	for _, r := range regions {
+		buf.WriteString(r.text)
+		buf.WriteString("\n\n")
+	}
 
-// generateCompileCheckFunction generates the _() function that will fail to compile if the constant values have changed.
-func generateCompileCheckFunction(f *jen.File, xVarName string, cs []constNameAndString, kind constant.Kind) *jen.Statement {
-	return f.Func().Id("_").Params().BlockFunc(func(g *jen.Group) {
-		g.Var().Id(xVarName).Index(jen.Lit(1)).Struct()
-		g.Comment(`An "invalid array index" compiler error signifies that the constant values have changed.`)
-		g.Commentf(`Re-run the %s command to generate them again.`, os.Args[0])
-		for _, c := range cs {
-			switch kind {
-			case constant.String:
-				v := constant.StringVal(c.Const.Val())
-				g.Line()
-				g.Commentf("Begin %q", v)
-				for i, b := range []byte(v) {
-					g.Id("_").Op("=").Id(xVarName).Index(jen.LitByte(b).Op("-").Id(c.Name).Index(jen.Lit(i)))
-				}
-			default:
-				// using jen.Op here is a bit of a hack, but it allows us to
-				// insert the string verbatim without surrounding it with a
-				// type cast (as Lit does)
-				g.Id("_").Op("=").Id(xVarName).Index(jen.Id(c.Name).Op("-").Op(c.Const.Val().ExactString()))
-			}
-		}
+	return imports.Process(name, buf.Bytes(), &imports.Options{
+		Comments:  true,
+		TabIndent: true,
+		TabWidth:  8,
 	})
 }
 
-// generateNextMethod generates the Next() method for the enum.
-func generateNextMethod(f *jen.File, tn *types.TypeName, receiver string, cs []constNameAndString, kind constant.Kind) {
-	var zero interface{} = 0
-	if kind == constant.String {
-		zero = `""`
-	}
-
-	f.Commentf("Next returns the next defined %s. If %s is not defined, then Next returns the first defined value.", tn.Name(), receiver)
-	f.Commentf("Next() can be used to loop through all values of an enum.")
-	f.Commentf("")
-	f.Commentf("\t%s := %s(%v)", receiver, tn.Name(), zero)
-	f.Comment("\tfor {")
-	f.Commentf("\t\tfmt.Println(%s)", receiver)
-	f.Commentf("\t\t%s = %s.Next()", receiver, receiver)
-	f.Commentf("\t\tif %s == %s(%v) {", receiver, tn.Name(), zero)
-	f.Comment("\t\t\tbreak")
-	f.Comment("\t\t}")
-	f.Comment("\t}")
-	f.Commentf("")
-	f.Commentf("The exact order that values are returned when looping should not be relied upon.")
-	f.Func().Params(jen.Id(receiver).Id(tn.Name())).Id("Next").Params().Id(tn.Name()).Block(
-		jen.Switch(jen.Id(receiver)).BlockFunc(func(g *jen.Group) {
-			for i, c := range cs {
-				ni := (i + 1) % len(cs)
-				g.Case(jen.Id(c.Name)).Block(jen.Return(jen.Id(cs[ni].Name)))
-			}
-			if len(cs) > 0 {
-				g.Default().Block(jen.Return(jen.Id(cs[0].Name)))
-			}
-		}),
-	)
-}
+// splitGeneratedFile splits src, the contents of a generated file, into the comments before the package clause,
+// the package name, the import specs and the code after the imports.
+func splitGeneratedFile(name string, src []byte) (header, pkgName string, importSpecs []string, body string, err error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, name, src, parser.ImportsOnly|parser.ParseComments)
+	if err != nil {
+		return "", "", nil, "", err
+	}
 
-// generateScanMethod generates the Scan() method for the enum.
-func generateScanMethod(f *jen.File, tn *types.TypeName, receiver string, scanStateVarName string, verbVarName string, tokenVarName string, cs []constNameAndString) {
-	f.Commentf("Scan implements [fmt.Scanner]. Use [fmt.Scan] to parse strings into %s values", tn.Name())
-	f.Func().Params(jen.Id(receiver).Op("*").Id(tn.Name())).Id("Scan").Params(jen.Id(scanStateVarName).Qual("fmt", "ScanState"), jen.Id(verbVarName).Rune()).Error().Block(
-		jen.List(jen.Id(tokenVarName), jen.Err()).Op(":=").Id(scanStateVarName).Dot("Token").Call(jen.True(), jen.Nil()),
-		jen.If(jen.Err().Op("!=").Nil()).Block(
-			jen.Return(jen.Err()),
-		),
-
-		jen.Line(),
-		jen.Switch(jen.String().Parens(jen.Id(tokenVarName))).BlockFunc(func(g *jen.Group) {
-			for _, c := range cs {
-				g.Case(jen.Lit(c.String)).Block(
-					jen.Op("*").Id(receiver).Op("=").Id(c.Name),
-				)
-			}
-			g.Default().Block(
-				jen.Return(jen.Qual("fmt", "Errorf").Call(jen.Lit("unknown "+tn.Name()+" value: %s"), jen.Id(tokenVarName))),
-			)
-		}),
+	for _, spec := range file.Imports {
+		importSpecs = append(importSpecs, string(src[fset.Position(spec.Pos()).Offset:fset.Position(spec.End()).Offset]))
+	}
 
-		jen.Return(jen.Nil()),
-	)
-}
+	end := file.Name.End()
+	if len(file.Decls) > 0 {
+		end = file.Decls[len(file.Decls)-1].End()
+	}
 
-// generateDefinedMethod generates the Defined() method for the enum.
-func generateDefinedMethod(f *jen.File, receiver string, tn *types.TypeName, cs []constNameAndString) {
-	f.Commentf("Defined returns true if %s holds a defined value.", receiver)
-	f.Func().Params(jen.Id(receiver).Id(tn.Name())).Id("Defined").Params().Bool().Block(
-		jen.Switch(jen.Id(receiver)).Block(
-			jen.CaseFunc(func(g *jen.Group) {
-				for _, c := range cs {
-					g.Op(c.Const.Val().ExactString())
-				}
-			}).Block(jen.Return(jen.True())),
-			jen.Default().Block(jen.Return(jen.False())),
-		),
-	)
+	header = string(src[:fset.Position(file.Package).Offset])
+	body = string(src[fset.Position(end).Offset:])
+	return header, file.Name.Name, importSpecs, body, nil
 }
 
-// generateStringMethod generates the String() method for the enum.
-func generateStringMethod(f *jen.File, receiver string, kind constant.Kind, eType *types.TypeName, cs []constNameAndString, anyOverrides bool) {
-	f.Commentf("String implements [fmt.Stringer]. If !%s.Defined(), then a generated string is returned based on %s's value.", receiver, receiver)
-	switch kind {
-	case constant.String:
-
-		f.Func().Params(jen.Id(receiver).Id(eType.Name())).Id("String").Params().String().BlockFunc(func(g *jen.Group) {
-			if anyOverrides {
-				g.Switch(jen.Id(receiver)).BlockFunc(func(g *jen.Group) {
-					for _, c := range cs {
-						if c.Name == c.String {
-							continue
-						}
-
-						g.Case(jen.Id(c.Name)).Block(jen.Return(jen.Lit(c.String)))
-					}
-				})
+// findGeneratedRegions returns the regions between the begin and end markers in body, in the order they appear.
+func findGeneratedRegions(body string) ([]generatedRegion, error) {
+	var ret []generatedRegion
+	var current *generatedRegion
+	for _, line := range strings.SplitAfter(body, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if current == nil {
+			if typeName, ok := strings.CutPrefix(trimmed, regionBegin("")); ok {
+				current = &generatedRegion{typeName: typeName}
+				current.text = trimmed + "\n"
 			}
+			continue
+		}
 
-			g.Return(jen.String().Parens(jen.Id(receiver)))
-		})
+		if trimmed == regionEnd(current.typeName) {
+			current.text += trimmed
+			ret = append(ret, *current)
+			current = nil
+			continue
+		}
 
-	default:
-		f.Func().Params(jen.Id(receiver).Id(eType.Name())).Id("String").Params().String().Block(
-			jen.Switch(jen.Id(receiver)).BlockFunc(func(g *jen.Group) {
-				for _, c := range cs {
-					g.Case(jen.Id(c.Name)).Block(jen.Return(jen.Lit(c.String)))
-				}
-			}),
-			jen.Return(jen.Qual("fmt", "Sprintf").Call(jen.Lit(fmt.Sprintf("%s(%%d)", eType.Name())), jen.Id(receiver))),
-		)
+		current.text += line
 	}
-}
 
-// generateBytesMethod generates the Bytes() method for the enum.
-func generateBytesMethod(f *jen.File, receiver string, kind constant.Kind, eType *types.TypeName, cs []constNameAndString, anyOverrides bool) {
-	f.Commentf("Bytes returns a byte-level representation of String(). If !%s.Defined(), then a generated string is returned based on %s's value.", receiver, receiver)
-	switch kind {
-	case constant.String:
-		f.Func().Params(jen.Id(receiver).Id(eType.Name())).Id("Bytes").Params().Op("[]").Byte().BlockFunc(func(g *jen.Group) {
-			if anyOverrides {
-				g.Switch(jen.Id(receiver)).BlockFunc(func(g *jen.Group) {
-					for _, c := range cs {
-						if c.Name == c.String {
-							continue
-						}
-
-						g.Case(jen.Id(c.Name)).Block(jen.Return(jen.Op("[]").Byte().Parens(jen.Lit(c.String))))
-					}
-				})
-			}
-			g.Return(jen.Op("[]").Byte().Parens(jen.Id(receiver)))
-		})
-	default:
-		f.Func().Params(jen.Id(receiver).Id(eType.Name())).Id("Bytes").Params().Op("[]").Byte().Block(
-			jen.Switch(jen.Id(receiver)).BlockFunc(func(g *jen.Group) {
-				for _, c := range cs {
-					g.Case(jen.Id(c.Name)).Block(jen.ReturnFunc(func(g *jen.Group) {
-						g.Op("[]").Byte().ValuesFunc(func(g *jen.Group) {
-							n := c.String
-							for r, size := utf8.DecodeRuneInString(n); len(n) > 0 && r != utf8.RuneError; r, size = utf8.DecodeRuneInString(n) {
-								n = n[size:]
-								g.LitRune(r)
-							}
-						})
-					}))
-				}
-			}),
-			jen.Return(jen.Op("[]").Byte().Parens(jen.Qual("fmt", "Sprintf").Call(jen.Lit(fmt.Sprintf("%s(%%d)", eType.Name())), jen.Id(receiver)))),
-		)
+	if current != nil {
+		return nil, fmt.Errorf("missing %q", regionEnd(current.typeName))
 	}
-}
-
-func generateTextMarshal(f *jen.File, receiver string, eType *types.TypeName) {
-	f.Commentf("MarshalText implements [encoding.TextMarshaler]")
-	f.Func().Params(jen.Id(receiver).Id(eType.Name())).Id("MarshalText").Params().Params(jen.Op("[]").Byte(), jen.Error()).Block(
-		jen.Return(jen.Id(receiver).Dot("Bytes").Call(), jen.Nil()),
-	)
-}
 
-func generateTextUnmarshal(f *jen.File, receiver string, eType *types.TypeName, cs []constNameAndString, varName string) {
-	f.Commentf("UnmarshalText implements [encoding.TextUnmarshaler]")
-	f.Func().Params(jen.Id(receiver).Op("*").Id(eType.Name())).Id("UnmarshalText").Params(jen.Id(varName).Op("[]").Byte()).Params(jen.Error()).Block(
-		// This call should be optimized by compiler: https://github.com/golang/go/issues/24937
-		jen.Switch(jen.String().Parens(jen.Id(varName))).BlockFunc(func(g *jen.Group) {
-			for _, c := range cs {
-				g.Case(jen.Lit(c.String)).Block(jen.Op("*").Id(receiver).Op("=").Id(c.Name), jen.Return(jen.Nil()))
-			}
-			g.Default().Block(jen.Return(jen.Qual("fmt", "Errorf").Call(jen.Lit("failed to parse value %v into %T"), jen.Id(varName), jen.Op("*").Id(receiver))))
-		}),
-	)
+	return ret, nil
 }
 
-func generateTypeAssertions(f *jen.File, eType *types.TypeName, kind constant.Kind) {
-
-	var zero *jen.Statement
-	switch kind {
-	case constant.String:
-		zero = jen.Lit("")
-	case constant.Int:
-		zero = jen.Lit(0)
-	default:
-		panic("invalid constant type")
+// writeOutput writes src into the file name.
+// If --check was specified, the file is compared against src instead.
+// If --dry-run was specified, src is written to standard output along with name.
+func writeOutput(name string, src []byte) error {
+	if flagDryRun {
+		// the intended path is reported since it may be the computed default
+		fmt.Fprintf(os.Stdout, "// go-enumerator --dry-run: would write %s\n", name)
+		_, err := os.Stdout.Write(src)
+		return err
 	}
 
-	f.Var().Defs(
-		jen.Id("_").Qual("fmt", "Stringer").Op("=").Id(eType.Name()).Parens(zero.Clone()),
-		jen.Id("_").Qual("fmt", "Scanner").Op("=").New(jen.Id(eType.Name())),
-		jen.Id("_").Qual("encoding", "TextMarshaler").Op("=").Id(eType.Name()).Parens(zero.Clone()),
-		jen.Id("_").Qual("encoding", "TextUnmarshaler").Op("=").New(jen.Id(eType.Name())),
-	)
-}
+	if flagCheck {
+		if err := checkOutputFile(name, src); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
 
-// defaultReceiverName returns the default receiver name to use for tn
-func defaultReceiverName(tn *types.TypeName) string {
-	s, _ := utf8.DecodeRuneInString(tn.Name())
-	return unexportedName(string(s))
-}
+		verbosef("%s is up to date", name)
+		return nil
+	}
 
-// safeIndent returns an identifier that is safe to use (not a keyword,
-// and not already used). want is the requested identifier; not is a
-// list of identifiers that are already used.
-func safeIndent(want string, not ...string) string {
-	if token.IsKeyword(want) {
-		return safeIndent("_"+want, not...)
+	out, cleanup, err := openOutputFile(name)
+	if err != nil {
+		return err
 	}
+	defer cleanup()
 
-	for _, s := range not {
-		if want == s {
-			return safeIndent("_"+want, not...)
-		}
+	if _, err := out.Write(src); err != nil {
+		return err
 	}
 
-	return want
+	verbosef("wrote %s", name)
+	return nil
 }
 
 // openOutputFile opens/creates the file to write the output to.
@@ -785,18 +867,46 @@ func openOutputFile(name string) (*os.File, func(), error) {
 	}
 }
 
-// unexportedName returns s with the first character replaced
-// with its lower case version if it is upper case.
-func unexportedName(s string) string {
-	if !ast.IsExported(s) {
-		return s
+// checkOutputFile returns an error if the contents of the file name are not exactly want.
+// The error describes the first line that differs.
+func checkOutputFile(name string, want []byte) error {
+	switch name {
+	case "<STDOUT>", "<STDERR>":
+		return fmt.Errorf("cannot check %s", name)
 	}
 
-	start, size := utf8.DecodeRuneInString(s)
-	if size == 0 {
-		panic("s is empty")
+	have, err := os.ReadFile(name)
+	if errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("%s: generated file does not exist", name)
+	}
+	if err != nil {
+		return err
 	}
 
-	start = unicode.ToLower(start)
-	return string(start) + s[size:]
+	if bytes.Equal(have, want) {
+		return nil
+	}
+
+	haveLines := strings.Split(string(have), "\n")
+	wantLines := strings.Split(string(want), "\n")
+	for i := 0; ; i++ {
+		var h, w string
+		if i < len(haveLines) {
+			h = haveLines[i]
+		}
+		if i < len(wantLines) {
+			w = wantLines[i]
+		}
+
+		if h != w || i >= len(haveLines) || i >= len(wantLines) {
+			return fmt.Errorf("%s:%d: generated file is out of date\n-%s\n+%s", name, i+1, h, w)
+		}
+	}
+}
+
+// verbosef prints a message to standard error if --verbose is set.
+func verbosef(format string, args ...any) {
+	if flagVerbose {
+		fmt.Fprintf(os.Stderr, format+"\n", args...)
+	}
 }
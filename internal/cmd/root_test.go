@@ -0,0 +1,303 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/a-jentleman/go-enumerator/pkg/enumgen"
+	"github.com/spf13/pflag"
+)
+
+func TestCheckOutputFile(t *testing.T) {
+	dir := t.TempDir()
+	name := filepath.Join(dir, "kind_enum.go")
+
+	if err := checkOutputFile(name, []byte("package example\n")); err == nil || !strings.Contains(err.Error(), "does not exist") {
+		t.Errorf("checkOutputFile() = %v, want missing file error", err)
+	}
+
+	if err := os.WriteFile(name, []byte("package example\n\nfunc A() {}\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := checkOutputFile(name, []byte("package example\n\nfunc A() {}\n")); err != nil {
+		t.Errorf("checkOutputFile() = %v, want nil", err)
+	}
+
+	err := checkOutputFile(name, []byte("package example\n\nfunc B() {}\n"))
+	if err == nil {
+		t.Fatal("checkOutputFile() = nil, want error")
+	}
+
+	if want := name + ":3: generated file is out of date\n-func A() {}\n+func B() {}"; err.Error() != want {
+		t.Errorf("checkOutputFile() = %q, want = %q", err.Error(), want)
+	}
+
+	if err := checkOutputFile(name, []byte("package example\n\nfunc A() {}\nfunc B() {}\n")); err == nil {
+		t.Error("checkOutputFile() = nil, want error for extra lines")
+	}
+}
+
+func TestMergeGeneratedRegion(t *testing.T) {
+	generated := func(typeName, imp, body string) []byte {
+		return []byte("// Code generated by go-enumerator; DO NOT EDIT.\n// Command: go-enumerator --type=" + typeName + "\n\npackage example\n\nimport \"" + imp + "\"\n\n" + body + "\n")
+	}
+
+	got, err := mergeGeneratedRegion("zz_generated.go", nil, generated("Kind", "fmt", "var _ = fmt.Sprint(1)"), "Kind", enumgen.DefaultBanner)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err = mergeGeneratedRegion("zz_generated.go", got, generated("Color", "strings", "var _ = strings.ToLower(\"\")"), "Color", enumgen.DefaultBanner)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// regenerating Kind replaces its region, and fmt is no longer used
+	got, err = mergeGeneratedRegion("zz_generated.go", got, generated("Kind", "strconv", "var _ = strconv.Itoa(2)"), "Kind", enumgen.DefaultBanner)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `// Code generated by go-enumerator; DO NOT EDIT.
+
+package example
+
+import (
+	"strconv"
+	"strings"
+)
+
+// go-enumerator:begin Kind
+// Command: go-enumerator --type=Kind
+
+var _ = strconv.Itoa(2)
+
+// go-enumerator:end Kind
+
+// go-enumerator:begin Color
+// Command: go-enumerator --type=Color
+
+var _ = strings.ToLower("")
+
+// go-enumerator:end Color
+`
+	if string(got) != want {
+		t.Errorf("mergeGeneratedRegion() = \n%s\nwant = \n%s", got, want)
+	}
+
+	if _, err := mergeGeneratedRegion("zz_generated.go", []byte("package example\n"), generated("Kind", "fmt", "var _ = fmt.Sprint(1)"), "Kind", enumgen.DefaultBanner); err == nil {
+		t.Errorf("mergeGeneratedRegion() of a file that was not generated expected error")
+	}
+
+	// files are recognized by the banner they were generated with
+	custom := bytes.Replace(got, []byte(enumgen.DefaultBanner), []byte("Code generated by enumgen; DO NOT EDIT."), 1)
+	if _, err := mergeGeneratedRegion("zz_generated.go", custom, generated("Kind", "fmt", "var _ = fmt.Sprint(1)"), "Kind", enumgen.DefaultBanner); err == nil {
+		t.Errorf("mergeGeneratedRegion() of a file with a different banner expected error")
+	}
+	if _, err := mergeGeneratedRegion("zz_generated.go", custom, generated("Kind", "fmt", "var _ = fmt.Sprint(1)"), "Kind", "Code generated by enumgen; DO NOT EDIT."); err != nil {
+		t.Errorf("mergeGeneratedRegion() of a file with the configured banner = %v, want nil", err)
+	}
+
+	truncated := []byte(strings.TrimSuffix(want, "// go-enumerator:end Color\n"))
+	if _, err := mergeGeneratedRegion("zz_generated.go", truncated, generated("Kind", "fmt", "var _ = fmt.Sprint(1)"), "Kind", enumgen.DefaultBanner); err == nil {
+		t.Errorf("mergeGeneratedRegion() of a file with a missing end marker expected error")
+	}
+}
+
+func TestWriteOutputDryRun(t *testing.T) {
+	old := flagDryRun
+	flagDryRun = true
+	t.Cleanup(func() { flagDryRun = old })
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stdout := os.Stdout
+	os.Stdout = w
+	t.Cleanup(func() { os.Stdout = stdout })
+
+	name := filepath.Join(t.TempDir(), "kind_enum.go")
+	err = writeOutput(name, []byte("package example\n"))
+	_ = w.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := "// go-enumerator --dry-run: would write " + name + "\npackage example\n"; string(got) != want {
+		t.Errorf("writeOutput() wrote %q, want = %q", got, want)
+	}
+
+	if _, err := os.Stat(name); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("os.Stat() = %v, want = %v", err, os.ErrNotExist)
+	}
+}
+
+func TestReadHeader(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "header.txt")
+	if err := os.WriteFile(name, []byte("Copyright 2021 Example\r\n\r\n// already a comment\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := readHeader(name, []string{"SPDX-License-Identifier: MIT"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"Copyright 2021 Example", "", "// already a comment", "SPDX-License-Identifier: MIT"}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("readHeader() = %q, want = %q", got, want)
+	}
+
+	if _, err := readHeader(filepath.Join(t.TempDir(), "missing.txt"), nil); err == nil {
+		t.Error("readHeader() = nil, want error")
+	}
+
+}
+
+func TestResolveParameterValue(t *testing.T) {
+	const env = "GO_ENUMERATOR_TEST_LINE"
+	tests := []struct {
+		args   []string
+		setEnv bool
+		env    string
+		want   string
+		wantOk bool
+	}{
+		{nil, false, "", "7", false},
+		{nil, true, "12", "12", true},
+		{nil, true, "", "", true},
+		{[]string{"--line=3"}, true, "12", "3", true},
+		// an explicitly empty flag is not replaced by the environment variable or the default
+		{[]string{"--line="}, true, "12", "", true},
+		{[]string{"--line="}, false, "", "", true},
+	}
+
+	for _, tt := range tests {
+		// t.Setenv restores the variable after the test, even if it is unset
+		t.Setenv(env, tt.env)
+		if !tt.setEnv {
+			os.Unsetenv(env)
+		}
+
+		fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+		fs.String("line", "7", "")
+		if err := fs.Parse(tt.args); err != nil {
+			t.Fatal(err)
+		}
+
+		got, ok := resolveParameterValue(fs.Lookup("line"), env)
+		if got != tt.want || ok != tt.wantOk {
+			t.Errorf("resolveParameterValue(%v, env = %q) = %q, %v, want = %q, %v", tt.args, tt.env, got, ok, tt.want, tt.wantOk)
+		}
+	}
+}
+
+func TestConfigFile(t *testing.T) {
+	root := t.TempDir()
+	dir := filepath.Join(root, "internal", "enums")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	name := filepath.Join(root, configFileBaseName)
+	config := "naming-strategy: snake_case\nreceiver: x\njson: true\nexclude: [KindUnknown, KindMax]\nheader:\n  - Copyright 2021 Example\n  - \"SPDX-License-Identifier: MIT\"\n"
+	if err := os.WriteFile(name, []byte(config), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := findConfigFile(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got != name {
+		t.Fatalf("findConfigFile() = %q, want = %q", got, name)
+	}
+
+	newFlagSet := func() *pflag.FlagSet {
+		fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+		fs.String("input", "", "")
+		fs.String("naming-strategy", "none", "")
+		fs.String("receiver", "", "")
+		fs.Bool("json", false, "")
+		fs.StringSlice("exclude", nil, "")
+		fs.StringArray("header", nil, "")
+		return fs
+	}
+
+	// flags given on the command line take precedence
+	fs := newFlagSet()
+	if err := fs.Parse([]string{"--receiver=k"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := applyConfigFile(fs, name); err != nil {
+		t.Fatal(err)
+	}
+
+	for flag, want := range map[string]string{
+		"naming-strategy": "snake_case",
+		"receiver":        "k",
+		"json":            "true",
+		"exclude":         "[KindUnknown,KindMax]",
+		"header":          "[Copyright 2021 Example,SPDX-License-Identifier: MIT]",
+	} {
+		if got := fs.Lookup(flag).Value.String(); got != want {
+			t.Errorf("--%s = %q, want = %q", flag, got, want)
+		}
+	}
+
+	for config, wantErr := range map[string]string{
+		"unknown: true\n":  "unknown flag",
+		"input: kind.go\n": "can't be set in a config file",
+		"json: maybe\n":    "invalid value for json",
+		"receiver:\n":      "missing value",
+		"json: [true\n":    "failed to parse",
+		"header: [a: b]\n": "must be a string",
+	} {
+		if err := os.WriteFile(name, []byte(config), 0o644); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := applyConfigFile(newFlagSet(), name); err == nil || !strings.Contains(err.Error(), wantErr) {
+			t.Errorf("applyConfigFile(%q) = %v, want error containing %q", config, err, wantErr)
+		}
+	}
+}
+
+func TestOutputFileNames(t *testing.T) {
+	for name, want := range map[string]string{
+		"kind_enum.go": "kind_enum_test.go",
+		"out":          "out_test.go",
+		"<STDOUT>":     "<STDOUT>",
+		"<STDERR>":     "<STDERR>",
+	} {
+		if got := testFileName(name); got != want {
+			t.Errorf("testFileName(%q) = %q, want = %q", name, got, want)
+		}
+	}
+
+	for name, want := range map[string]string{
+		"kind_enum.go": "kind_enum_bench_test.go",
+		"out":          "out_bench_test.go",
+		"<STDOUT>":     "<STDOUT>",
+	} {
+		if got := benchFileName(name); got != want {
+			t.Errorf("benchFileName(%q) = %q, want = %q", name, got, want)
+		}
+	}
+}
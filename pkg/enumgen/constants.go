@@ -0,0 +1,525 @@
+package enumgen
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"os/exec"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/stoewer/go-strcase"
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+type constNameAndString struct {
+	Const  *types.Const
+	Name   string
+	String string
+
+	// Description is a human-readable description of the value, if any.
+	Description string
+
+	// Literal is the integer literal the constant was declared with in source,
+	// if any. It is used to preserve the base (e.g. hex) of the value.
+	Literal string
+
+	// Override is set if String was given in the line comment of the constant,
+	// or is the value of a string constant, rather than derived from its name.
+	Override bool
+}
+
+// literal returns the source representation of the constant's value.
+// If the value was not declared with an integer literal, it is formatted using constantLiteral.
+func (c constNameAndString) literal(basic *types.Basic) string {
+	if c.Literal != "" {
+		return c.Literal
+	}
+
+	return constantLiteral(c.Const.Val(), basic)
+}
+
+// findOptions controls how findConstants selects constants and derives their string representations.
+type findOptions struct {
+	NamingStrategy namingStrategyName // converts the names of constants to string representations
+	TrimPrefix     string             // removed from the name of each constant before NamingStrategy is applied
+	Prefix         string             // added to the string representations derived from names
+
+	CommentTag   string   // marker of the line comments that are read, as described by parseLineComment
+	Descriptions bool     // use the doc comments of constants as their descriptions
+	Exclude      []string // names of the constants to skip
+	OnlyMarked   bool     // skip constants whose line comment is not marked with CommentTag
+	Strict       bool     // return an error for line comments that can't be read or are empty overrides
+
+	Banner string // the "Code generated" line of the files whose constants are skipped
+}
+
+// findConstantsOfType finds all constants in info that are of type obj, as described by findConstants.
+func findConstantsOfType(fset *token.FileSet, info *types.Info, syntax []*ast.File, obj types.Object, opts findOptions) ([]constNameAndString, constant.Kind, error) {
+	ofType := func(c *types.Const) bool {
+		t, ok := types.Unalias(c.Type()).(*types.Named)
+		return ok && t.Obj() == obj
+	}
+
+	return findConstants(fset, info, syntax, ofType, obj.Pos(), opts)
+}
+
+// findConstantsInGroup finds all constants declared in the const block decl, as described by findConstants.
+func findConstantsInGroup(fset *token.FileSet, info *types.Info, syntax []*ast.File, decl *ast.GenDecl, opts findOptions) ([]constNameAndString, constant.Kind, error) {
+	inGroup := func(c *types.Const) bool {
+		return decl.Pos() <= c.Pos() && c.Pos() < decl.End()
+	}
+
+	return findConstants(fset, info, syntax, inGroup, decl.Pos(), opts)
+}
+
+// findConstants finds all named constants in info for which match returns true.
+// opts.TrimPrefix is removed from the name of each constant before opts.NamingStrategy is applied,
+// and opts.Prefix is added to the result. Line comments override the result as described by parseLineComment.
+// Without a line comment, the string representation of a string constant is its value.
+// If opts.Descriptions is set, the doc comments of constants are used as their descriptions,
+// unless a description is given in their line comment. Constants named in opts.Exclude are skipped,
+// as are constants declared in files generated by go-enumerator, which are recognized by opts.Banner.
+// If opts.OnlyMarked is set, constants are also skipped unless their line comment is marked with opts.CommentTag,
+// as described by cutMarker.
+// The constants are sorted by their position, and those declared in the same file as pos come first.
+// An error is returned if the constants do not all have the same valid constant.Kind.
+func findConstants(fset *token.FileSet, info *types.Info, syntax []*ast.File, match func(*types.Const) bool, pos token.Pos, opts findOptions) ([]constNameAndString, constant.Kind, error) {
+	var ret []constNameAndString
+	for _, object := range info.Defs {
+		if object == nil {
+			continue
+		}
+
+		c, ok := object.(*types.Const)
+		if !ok {
+			continue
+		}
+
+		if c.Name() == "_" {
+			continue
+		}
+
+		if !match(c) {
+			continue
+		}
+
+		if slices.Contains(opts.Exclude, c.Name()) {
+			continue
+		}
+
+		name := c.Name()
+		// the file may not be found for positions that don't map to the syntax trees,
+		// such as those of cgo-generated code. There are no comments to read in that case.
+		var nodes []ast.Node
+		astFile := findAstFileForToken(c.Pos(), syntax)
+		if isGeneratedFile(astFile, opts.Banner) {
+			continue
+		}
+		if astFile != nil {
+			nodes, _ = astutil.PathEnclosingInterval(astFile, c.Pos(), c.Pos())
+		} else if opts.Strict {
+			return nil, constant.Unknown, fmt.Errorf("%s: the file declaring constant %s was not found, so its line comment can't be read (--strict)", fset.Position(c.Pos()), name)
+		}
+
+		comment, hasComment := findStringInLineComment(c.Pos(), nodes, astFile, fset)
+		if opts.OnlyMarked {
+			var marked bool
+			comment, marked = cutMarker(comment, opts.CommentTag)
+			if !marked {
+				continue
+			}
+
+			// a comment that is only the marker doesn't override the string representation
+			hasComment = comment != ""
+		}
+		if opts.Strict && hasComment && isEmptyOverride(comment, opts.CommentTag) {
+			return nil, constant.Unknown, fmt.Errorf("%s: constant %s has an empty string representation in its line comment (--strict)", fset.Position(c.Pos()), name)
+		}
+
+		str, desc, override := parseLineComment(comment, opts.CommentTag)
+		if desc == "" && opts.Descriptions {
+			desc = findDocComment(nodes)
+		}
+		if !override && c.Val().Kind() == constant.String {
+			// String() returns the values of string constants, so they are also what is parsed
+			str, override = constant.StringVal(c.Val()), true
+		}
+		if !override {
+			trimmed := strings.TrimPrefix(name, opts.TrimPrefix)
+			switch opts.NamingStrategy {
+			case camelCase:
+				str = strcase.LowerCamelCase(trimmed)
+			case pascalCase:
+				str = strcase.UpperCamelCase(trimmed)
+			case snakeCase:
+				str = strcase.SnakeCase(trimmed)
+			case upperSnakeCase:
+				str = strcase.UpperSnakeCase(trimmed)
+			case kebabCase:
+				str = strcase.KebabCase(trimmed)
+			case titleCase:
+				str = displayCase(trimmed, true)
+			case sentenceCase:
+				str = displayCase(trimmed, false)
+			default:
+				str = trimmed
+			}
+
+			str = opts.Prefix + str
+		}
+
+		cn := constNameAndString{
+			Const:       c,
+			Name:        name,
+			String:      str,
+			Description: desc,
+			Literal:     findIntLiteral(c, nodes),
+			Override:    override,
+		}
+
+		ret = append(ret, cn)
+	}
+
+	if len(ret) == 0 {
+		return nil, constant.Unknown, nil
+	}
+
+	// Sort the items based on where they show up in source code.
+	// This is mainly to avoid significant differences in version control overtime.
+	// Constants declared in the same file as the type come first, since constants
+	// in other files are usually extensions of the ones declared alongside the type.
+	typeFilename := fset.Position(pos).Filename
+	sort.Slice(ret, func(i, j int) bool {
+		ip := fset.Position(ret[i].Const.Pos())
+		jp := fset.Position(ret[j].Const.Pos())
+
+		iTypeFile := ip.Filename == typeFilename
+		jTypeFile := jp.Filename == typeFilename
+		if iTypeFile != jTypeFile {
+			return iTypeFile
+		}
+
+		return ip.Filename < jp.Filename ||
+			ip.Filename == jp.Filename && ip.Offset < jp.Offset
+	})
+
+	// The kinds are checked after sorting so that errors consistently refer to the same constants.
+	kind := ret[0].Const.Val().Kind()
+	for _, c := range ret {
+		k := c.Const.Val().Kind()
+		if k == constant.Unknown {
+			return nil, constant.Unknown, fmt.Errorf("%s: constant %s has an invalid value", fset.Position(c.Const.Pos()), c.Name)
+		}
+
+		if k != kind {
+			return nil, constant.Unknown, fmt.Errorf("%s: constant %s has kind %s, but %s has kind %s", fset.Position(c.Const.Pos()), c.Name, k, ret[0].Name, kind)
+		}
+	}
+
+	return ret, kind, nil
+}
+
+// namingExec converts constant names to string representations with an external command, for --naming-exec.
+// The names are written to the standard input of the command, one per line, and it must write
+// the string representation of each name to its standard output, one per line and in the same order.
+// Results are cached, so that each name is only converted once.
+type namingExec struct {
+	args  []string
+	cache map[string]string
+}
+
+// newNamingExec returns a namingExec for command, whose arguments are separated by spaces.
+func newNamingExec(command string) (*namingExec, error) {
+	args := strings.Fields(command)
+	if len(args) == 0 {
+		return nil, errors.New("--naming-exec must not be empty")
+	}
+
+	return &namingExec{args: args, cache: make(map[string]string)}, nil
+}
+
+// apply sets the string representation of each constant in cs that is not overridden by its line comment
+// to prefix followed by the conversion of its name without trimPrefix. The command runs at most once,
+// with the names that have not been converted before.
+func (n *namingExec) apply(cs []constNameAndString, trimPrefix, prefix string) error {
+	var names []string
+	for _, c := range cs {
+		name := strings.TrimPrefix(c.Name, trimPrefix)
+		if _, ok := n.cache[name]; c.Override || ok || slices.Contains(names, name) {
+			continue
+		}
+
+		names = append(names, name)
+	}
+
+	if len(names) > 0 {
+		if err := n.run(names); err != nil {
+			return err
+		}
+	}
+
+	for i := range cs {
+		if !cs[i].Override {
+			cs[i].String = prefix + n.cache[strings.TrimPrefix(cs[i].Name, trimPrefix)]
+		}
+	}
+
+	return nil
+}
+
+// run converts names with the command and caches the results.
+func (n *namingExec) run(names []string) error {
+	var stderr bytes.Buffer
+	cmd := exec.Command(n.args[0], n.args[1:]...)
+	cmd.Stdin = strings.NewReader(strings.Join(names, "\n") + "\n")
+	cmd.Stderr = &stderr
+
+	out, err := cmd.Output()
+	if err != nil {
+		return fmt.Errorf("--naming-exec %s failed: %w: %s", n.args[0], err, strings.TrimSpace(stderr.String()))
+	}
+
+	lines := strings.Split(strings.TrimSuffix(string(out), "\n"), "\n")
+	if len(lines) != len(names) {
+		return fmt.Errorf("--naming-exec %s wrote %d lines for %d names", n.args[0], len(lines), len(names))
+	}
+
+	for i, name := range names {
+		n.cache[name] = strings.TrimSuffix(lines[i], "\r")
+	}
+
+	return nil
+}
+
+// applyStringFormat sets the string representations of the constants in cs to prefix followed by
+// their values formatted with format, for --string-format. Line comment overrides are kept.
+func applyStringFormat(tn *types.TypeName, cs []constNameAndString, format, prefix string) error {
+	if basic, ok := tn.Type().Underlying().(*types.Basic); !ok || basic.Info()&types.IsInteger == 0 {
+		return fmt.Errorf("--string-format requires an integer type, but the underlying type of %s is %s", tn.Name(), tn.Type().Underlying())
+	}
+
+	for i := range cs {
+		if cs[i].Override {
+			continue
+		}
+
+		// values that don't fit in an int64 are positive, so they fit in a uint64
+		var v any
+		if n, exact := constant.Int64Val(cs[i].Const.Val()); exact {
+			v = n
+		} else {
+			v, _ = constant.Uint64Val(cs[i].Const.Val())
+		}
+
+		cs[i].String = prefix + fmt.Sprintf(format, v)
+	}
+
+	return nil
+}
+
+// findIntLiteral returns the integer literal that c is declared with in nodes.
+// An empty string is returned if c is not declared with a single integer literal,
+// such as values derived from iota.
+func findIntLiteral(c *types.Const, nodes []ast.Node) string {
+	for _, node := range nodes {
+		vs, ok := node.(*ast.ValueSpec)
+		if !ok {
+			continue
+		}
+
+		for i, ident := range vs.Names {
+			if ident.Pos() != c.Pos() || i >= len(vs.Values) {
+				continue
+			}
+
+			if lit, ok := vs.Values[i].(*ast.BasicLit); ok && lit.Kind == token.INT {
+				return lit.Value
+			}
+		}
+	}
+	return ""
+}
+
+// findExcludedConstantsOfType finds the constants of type obj that are named in exclude.
+// They are not part of the enum, but they are still included in the compile check.
+func findExcludedConstantsOfType(fset *token.FileSet, info *types.Info, obj types.Object, exclude []string) []constNameAndString {
+	var ret []constNameAndString
+	for _, object := range info.Defs {
+		c, ok := object.(*types.Const)
+		if !ok || !slices.Contains(exclude, c.Name()) {
+			continue
+		}
+
+		t, ok := types.Unalias(c.Type()).(*types.Named)
+		if !ok || t.Obj() != obj {
+			continue
+		}
+
+		ret = append(ret, constNameAndString{Const: c, Name: c.Name()})
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		ip := fset.Position(ret[i].Const.Pos())
+		jp := fset.Position(ret[j].Const.Pos())
+		return ip.Filename < jp.Filename ||
+			ip.Filename == jp.Filename && ip.Offset < jp.Offset
+	})
+
+	return ret
+}
+
+// findBlankConstantsOfType finds all constants of type obj that are declared with the
+// blank identifier, which are usually used to skip values in an iota sequence.
+func findBlankConstantsOfType(fset *token.FileSet, info *types.Info, obj types.Object) []*types.Const {
+	var ret []*types.Const
+	for _, object := range info.Defs {
+		c, ok := object.(*types.Const)
+		if !ok || c.Name() != "_" {
+			continue
+		}
+
+		t, ok := types.Unalias(c.Type()).(*types.Named)
+		if !ok || t.Obj() != obj {
+			continue
+		}
+
+		ret = append(ret, c)
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		ip := fset.Position(ret[i].Pos())
+		jp := fset.Position(ret[j].Pos())
+		return ip.Filename < jp.Filename ||
+			ip.Filename == jp.Filename && ip.Offset < jp.Offset
+	})
+
+	return ret
+}
+
+// parseLineComment parses the line comment of a constant into its string representation and description.
+// If the comment uses struct tag syntax, such as enum:"active" desc:"The active state", the values of
+// the tag key and desc are returned. Otherwise, the whole comment is the string representation, except
+// for a comment that is only "", which explicitly represents the value as an empty string.
+// override reports whether str overrides the string representation. It doesn't for empty comments and
+// empty values of key, which fall back to the name of the constant.
+func parseLineComment(comment, key string) (str, desc string, override bool) {
+	if comment == `""` {
+		return "", "", true
+	}
+
+	tag := reflect.StructTag(comment)
+	str, hasStr := tag.Lookup(key)
+	desc, hasDesc := tag.Lookup("desc")
+	if !hasStr && !hasDesc {
+		return comment, "", comment != ""
+	}
+
+	return str, desc, str != ""
+}
+
+// findDocComment returns the doc comment of the constant declared in nodes, with whitespace collapsed
+// so that it fits on one line. The doc comment of a const declaration without parentheses is used
+// if the constant itself has none.
+func findDocComment(nodes []ast.Node) string {
+	var doc *ast.CommentGroup
+	for _, node := range nodes {
+		switch n := node.(type) {
+		case *ast.ValueSpec:
+			doc = n.Doc
+		case *ast.GenDecl:
+			if doc == nil && !n.Lparen.IsValid() {
+				doc = n.Doc
+			}
+		}
+	}
+
+	if doc == nil {
+		return ""
+	}
+
+	return strings.Join(strings.Fields(doc.Text()), " ")
+}
+
+// findStringInLineComment returns the text of the line comment of the constant declared at pos.
+// The second result is false if the constant has no line comment.
+func findStringInLineComment(pos token.Pos, nodes []ast.Node, astFile *ast.File, tokenFile *token.FileSet) (string, bool) {
+	for _, node := range nodes {
+		gd, ok := node.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+
+		for _, cg := range astFile.Comments {
+			cgPos := cg.Pos()
+			if cgPos < gd.Pos() {
+				continue
+			}
+
+			cgPosition := tokenFile.Position(cgPos)
+			position := tokenFile.Position(pos)
+			if cgPosition.Line != position.Line {
+				continue
+			}
+
+			return strings.TrimSpace(cg.Text()), true
+		}
+	}
+	return "", false
+}
+
+// cutMarker returns comment, the text of a line comment, without marker, and whether comment is marked with it.
+// A comment is marked if it starts with the word marker, such as "enum" or "enum active", in which case
+// the rest of the comment overrides the string representation as usual. Comments that use struct tag syntax
+// with marker as the key, such as enum:"active", are marked as well, and they are returned unchanged.
+func cutMarker(comment, marker string) (string, bool) {
+	rest, ok := strings.CutPrefix(comment, marker)
+	switch {
+	case !ok:
+		return comment, false
+	case rest == "":
+		return "", true
+	case rest[0] == ':':
+		return comment, true
+	case rest[0] == ' ' || rest[0] == '\t':
+		return strings.TrimSpace(rest), true
+	}
+
+	// another word that starts with marker, such as "enumerated"
+	return comment, false
+}
+
+// isEmptyOverride returns true if comment, the text of a line comment, overrides the string
+// representation with an empty string. That's the case if the comment is empty, such as a comment
+// that only contains directives, or if key is given an empty value using struct tag syntax.
+func isEmptyOverride(comment, key string) bool {
+	if comment == "" {
+		return true
+	}
+
+	str, ok := reflect.StructTag(comment).Lookup(key)
+	return ok && str == ""
+}
+
+// canonicalConstants returns the first declared constant of each value in cs.
+func canonicalConstants(cs []constNameAndString) []constNameAndString {
+	var ret []constNameAndString
+	seen := make(map[string]bool, len(cs))
+	for _, c := range cs {
+		repr := c.Const.Val().ExactString()
+		if seen[repr] {
+			continue
+		}
+
+		seen[repr] = true
+		ret = append(ret, c)
+	}
+
+	return ret
+}
@@ -0,0 +1,513 @@
+package enumgen
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+func TestFindConstantsOfTypeMixedKinds(t *testing.T) {
+	fset, info, syntax, pkg := checkTestSource(t, `package example
+
+type Kind string
+
+const (
+	Kind1 Kind = "Kind1"
+	Kind2 Kind = 2
+)
+`)
+
+	_, _, err := findConstantsOfType(fset, info, syntax, pkg.Scope().Lookup("Kind"), findOptions{CommentTag: "enum", Banner: DefaultBanner})
+	if err == nil {
+		t.Fatal("findConstantsOfType() = nil, want error")
+	}
+
+	if want := "example.go:7:2: constant Kind2 has an invalid value"; err.Error() != want {
+		t.Errorf("findConstantsOfType() = %q, want = %q", err.Error(), want)
+	}
+}
+
+func TestFindConstantsOfTypeConflictingKinds(t *testing.T) {
+	fset, info, syntax, pkg := checkTestSource(t, `package example
+
+type Kind int
+
+const (
+	Kind1 Kind = 1
+	Kind2 Kind = 2
+)
+`)
+
+	// go/types never produces constants of one type with different kinds,
+	// so the value of Kind2 is replaced to simulate it.
+	for id, obj := range info.Defs {
+		if obj != nil && obj.Name() == "Kind2" {
+			info.Defs[id] = types.NewConst(obj.Pos(), pkg, obj.Name(), obj.Type(), constant.MakeString("2"))
+		}
+	}
+
+	_, _, err := findConstantsOfType(fset, info, syntax, pkg.Scope().Lookup("Kind"), findOptions{CommentTag: "enum", Banner: DefaultBanner})
+	if err == nil {
+		t.Fatal("findConstantsOfType() = nil, want error")
+	}
+
+	if want := "example.go:7:2: constant Kind2 has kind String, but Kind1 has kind Int"; err.Error() != want {
+		t.Errorf("findConstantsOfType() = %q, want = %q", err.Error(), want)
+	}
+}
+
+func TestFindConstantsOfTypeLiterals(t *testing.T) {
+	fset, info, syntax, pkg := checkTestSource(t, `package example
+
+type Kind int
+
+const (
+	Kind1 Kind = 0x01
+	Kind2 Kind = 0b10
+	Kind3 Kind = 0o7
+	Kind4 Kind = 10
+	Kind5 Kind = iota
+	Kind6
+)
+`)
+
+	cs, kind, err := findConstantsOfType(fset, info, syntax, pkg.Scope().Lookup("Kind"), findOptions{CommentTag: "enum", Banner: DefaultBanner})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"0x01", "0b10", "0o7", "10", "", ""}
+	for i, c := range cs {
+		if c.Literal != want[i] {
+			t.Errorf("%s.Literal = %q, want = %q", c.Name, c.Literal, want[i])
+		}
+	}
+
+	tn := pkg.Scope().Lookup("Kind").(*types.TypeName)
+	got := renderTestEnum(t, tn, cs, kind, generateOptions{})
+	if want := "case 0x01, 0b10, 0o7, 10, 4, 5:"; !containsCode(got, want) {
+		t.Errorf("generated code does not contain %q:\n%s", want, got)
+	}
+
+	if want := "_ = x[Kind1-0x01]"; !containsCode(got, want) {
+		t.Errorf("generated code does not contain %q:\n%s", want, got)
+	}
+}
+
+func TestFindBlankConstantsOfType(t *testing.T) {
+	fset, info, syntax, pkg := checkTestSource(t, `package example
+
+type Kind int
+
+const (
+	Kind1 Kind = iota
+	_
+	Kind3
+	Kind4 Kind = 1
+)
+`)
+
+	obj := pkg.Scope().Lookup("Kind")
+	blanks := findBlankConstantsOfType(fset, info, obj)
+	if len(blanks) != 1 || blanks[0].Val().ExactString() != "1" {
+		t.Fatalf("findBlankConstantsOfType() = %v, want the blank with value 1", blanks)
+	}
+
+	cs, kind, err := findConstantsOfType(fset, info, syntax, obj, findOptions{CommentTag: "enum", Banner: DefaultBanner})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Kind4 reuses the value skipped by the blank identifier
+	_, err = generateEnumCode(fset, "example", obj.(*types.TypeName), cs, kind, "k", "go-enumerator", generateOptions{Blanks: blanks})
+	if err == nil || !strings.Contains(err.Error(), "skipped with a blank identifier") {
+		t.Errorf("generateEnumCode() = %v, want skipped value error", err)
+	}
+}
+
+func TestFindConstantsOfTypePrefix(t *testing.T) {
+	fset, info, syntax, pkg := checkTestSource(t, `package example
+
+type Kind int
+
+const (
+	KindActive Kind = iota
+	KindInactive // inactive
+	KindPending
+)
+`)
+
+	obj := pkg.Scope().Lookup("Kind")
+	cs, kind, err := findConstantsOfType(fset, info, syntax, obj, findOptions{NamingStrategy: snakeCase, TrimPrefix: "Kind", Prefix: "order_status.", CommentTag: "enum", Banner: DefaultBanner})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"order_status.active", "inactive", "order_status.pending"}
+	for i, c := range cs {
+		if c.String != want[i] {
+			t.Errorf("%s.String = %q, want = %q", c.Name, c.String, want[i])
+		}
+	}
+
+	// a prefixed string can collide with an override
+	cs[1].String = "order_status.active"
+	if _, err := generateEnumCode(fset, "example", obj.(*types.TypeName), cs, kind, "k", "go-enumerator", generateOptions{}); err == nil {
+		t.Error("generateEnumCode() = nil, want error")
+	}
+}
+
+func TestParseLineComment(t *testing.T) {
+	tests := []struct {
+		comment      string
+		key          string
+		wantStr      string
+		wantDesc     string
+		wantOverride bool
+	}{
+		{"DifferentString", "enum", "DifferentString", "", true},
+		{"a plain comment", "enum", "a plain comment", "", true},
+		{`enum:"active"`, "enum", "active", "", true},
+		{`enum:"active" desc:"The active state"`, "enum", "active", "The active state", true},
+		{`desc:"The active state"`, "enum", "", "The active state", false},
+		{`name:"active"`, "name", "active", "", true},
+		{`name:"active"`, "enum", `name:"active"`, "", true},
+		{"", "enum", "", "", false},
+		{`enum:""`, "enum", "", "", false},
+		{`""`, "enum", "", "", true},
+	}
+
+	for _, tt := range tests {
+		str, desc, override := parseLineComment(tt.comment, tt.key)
+		if str != tt.wantStr || desc != tt.wantDesc || override != tt.wantOverride {
+			t.Errorf("parseLineComment(%q, %q) = %q, %q, %v, want = %q, %q, %v", tt.comment, tt.key, str, desc, override, tt.wantStr, tt.wantDesc, tt.wantOverride)
+		}
+	}
+}
+
+func TestFindConstantsOfTypeDescriptions(t *testing.T) {
+	fset, info, syntax, pkg := checkTestSource(t, `package example
+
+type Kind int
+
+// Kind1 is the first kind.
+const Kind1 Kind = 1
+
+const (
+	// Kind2 is the
+	// second kind.
+	Kind2 Kind = 2
+
+	// Kind3 is the third kind.
+	Kind3 Kind = 3 // desc:"overridden"
+
+	Kind4 Kind = 4
+)
+`)
+
+	for _, docDescriptions := range []bool{false, true} {
+		cs, _, err := findConstantsOfType(fset, info, syntax, pkg.Scope().Lookup("Kind"), findOptions{CommentTag: "enum", Descriptions: docDescriptions, Banner: DefaultBanner})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want := []string{"", "", "overridden", ""}
+		if docDescriptions {
+			want = []string{"Kind1 is the first kind.", "Kind2 is the second kind.", "overridden", ""}
+		}
+
+		for i, c := range cs {
+			if c.Description != want[i] {
+				t.Errorf("%s.Description = %q, want = %q (docDescriptions = %v)", c.Name, c.Description, want[i], docDescriptions)
+			}
+		}
+	}
+}
+
+func TestFindConstantsOfTypeExclude(t *testing.T) {
+	fset, info, syntax, pkg := checkTestSource(t, `package example
+
+type Kind int
+
+const (
+	KindUnknown Kind = iota
+	Kind1
+	Kind2
+	KindMax
+)
+`)
+
+	obj := pkg.Scope().Lookup("Kind")
+	exclude := []string{"KindUnknown", "KindMax"}
+	cs, _, err := findConstantsOfType(fset, info, syntax, obj, findOptions{CommentTag: "enum", Exclude: exclude, Banner: DefaultBanner})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(cs) != 2 || cs[0].Name != "Kind1" || cs[1].Name != "Kind2" {
+		t.Errorf("findConstantsOfType() = %v, want = [Kind1 Kind2]", cs)
+	}
+
+	excluded := findExcludedConstantsOfType(fset, info, obj, exclude)
+	if len(excluded) != 2 || excluded[0].Name != "KindUnknown" || excluded[1].Name != "KindMax" {
+		t.Errorf("findExcludedConstantsOfType() = %v, want = [KindUnknown KindMax]", excluded)
+	}
+}
+
+func TestFindConstantsOfTypeOnlyMarked(t *testing.T) {
+	fset, info, syntax, pkg := checkTestSource(t, `package example
+
+type Kind int
+
+const (
+	Kind1 Kind = iota // enum
+	Kind2             // enum kind_two
+	Kind3             // enum:"kind_three"
+	Kind4             // enumerated, but not marked
+	Kind5
+	KindDefault = Kind1
+)
+`)
+
+	cs, _, err := findConstantsOfType(fset, info, syntax, pkg.Scope().Lookup("Kind"), findOptions{CommentTag: "enum", OnlyMarked: true, Banner: DefaultBanner})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"Kind1:Kind1", "Kind2:kind_two", "Kind3:kind_three"}
+	var got []string
+	for _, c := range cs {
+		got = append(got, c.Name+":"+c.String)
+	}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("findConstantsOfType() = %v, want = %v", got, want)
+	}
+}
+
+func TestFindConstantsOfTypeStringValues(t *testing.T) {
+	fset, info, syntax, pkg := checkTestSource(t, `package example
+
+type Size string
+
+const (
+	Small  Size = "S"
+	Medium Size = "M" // medium
+	Large  Size = "L"
+)
+`)
+
+	// String() returns the values, so the naming strategy only applies to the names of other enums
+	cs, _, err := findConstantsOfType(fset, info, syntax, pkg.Scope().Lookup("Size"), findOptions{NamingStrategy: snakeCase, CommentTag: "enum", Banner: DefaultBanner})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"Small:S", "Medium:medium", "Large:L"}
+	var got []string
+	for _, c := range cs {
+		got = append(got, c.Name+":"+c.String)
+	}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("findConstantsOfType() = %v, want = %v", got, want)
+	}
+}
+
+func TestNamingExec(t *testing.T) {
+	if _, err := exec.LookPath("tr"); err != nil {
+		t.Skip("tr is not available")
+	}
+
+	n, err := newNamingExec("tr a-z A-Z")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tn, cs, _ := newTestEnum("Kind", types.Int, []string{"KindActive", "KindDeleted", "KindOther"}, []any{int64(0), int64(1), int64(2)})
+	cs[2].String, cs[2].Override = "other", true
+	if err := n.apply(cs, tn.Name(), "kind."); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"kind.ACTIVE", "kind.DELETED", "other"}
+	for i, c := range cs {
+		if c.String != want[i] {
+			t.Errorf("%s.String = %q, want = %q", c.Name, c.String, want[i])
+		}
+	}
+
+	// cached names don't run the command again
+	n.args = []string{"false"}
+	if err := n.apply(cs[:2], tn.Name(), ""); err != nil {
+		t.Errorf("apply() with cached names = %v, want nil", err)
+	}
+
+	if err := n.apply([]constNameAndString{{Name: "KindNew"}}, tn.Name(), ""); err == nil {
+		t.Error("apply() with a failing command = nil, want error")
+	}
+
+	if _, err := newNamingExec(" "); err == nil {
+		t.Error("newNamingExec() with an empty command = nil, want error")
+	}
+}
+
+func TestFindConstantsOfTypeEmptyString(t *testing.T) {
+	fset, info, syntax, pkg := checkTestSource(t, `package example
+
+type Kind int
+
+const (
+	KindNone Kind = iota // ""
+	Kind1                //
+	Kind2                // ""
+)
+`)
+
+	tn := pkg.Scope().Lookup("Kind").(*types.TypeName)
+	cs, kind, err := findConstantsOfType(fset, info, syntax, tn, findOptions{CommentTag: "enum", Banner: DefaultBanner})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"KindNone:", "Kind1:Kind1", "Kind2:"}
+	var got []string
+	for _, c := range cs {
+		got = append(got, c.Name+":"+c.String)
+	}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("findConstantsOfType() = %v, want = %v", got, want)
+	}
+
+	// only one value can be represented by the empty string
+	wantErr := `duplicate string found: "" (KindNone at example.go:6:2 and Kind2 at example.go:8:2)`
+	if _, err := generateEnumCode(fset, "example", tn, cs, kind, "k", "go-enumerator", generateOptions{}); err == nil || err.Error() != wantErr {
+		t.Errorf("generateEnumCode() = %v, want = %s", err, wantErr)
+	}
+}
+
+func TestFindConstantsOfTypeGenerated(t *testing.T) {
+	// the constants generated by --min-max must not become part of the enum when it is regenerated
+	fset := token.NewFileSet()
+	var syntax []*ast.File
+	for _, src := range []string{`package example
+
+type Kind int
+
+const (
+	Kind1 Kind = iota
+	Kind2
+)
+`, `// Code generated by go-enumerator; DO NOT EDIT.
+
+package example
+
+const (
+	KindMin = Kind1
+	KindMax = Kind2
+)
+`} {
+		f, err := parser.ParseFile(fset, fmt.Sprintf("example%d.go", len(syntax)), src, parser.ParseComments)
+		if err != nil {
+			t.Fatal(err)
+		}
+		syntax = append(syntax, f)
+	}
+
+	info := &types.Info{Defs: make(map[*ast.Ident]types.Object)}
+	pkg, err := (&types.Config{}).Check("example", fset, syntax, info)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tn := pkg.Scope().Lookup("Kind").(*types.TypeName)
+	cs, kind, err := findConstantsOfType(fset, info, syntax, tn, findOptions{CommentTag: "enum", Banner: DefaultBanner})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(cs) != 2 || cs[0].Name != "Kind1" || cs[1].Name != "Kind2" {
+		t.Errorf("findConstantsOfType() = %v, want = [Kind1 Kind2]", cs)
+	}
+
+	opts := generateOptions{MinMax: true, Generated: generatedFiles(syntax, DefaultBanner)}
+	if len(opts.Generated) != 1 || opts.Generated[0] != syntax[1] {
+		t.Fatalf("generatedFiles() = %v, want the second file", opts.Generated)
+	}
+
+	if _, err := generateEnumCode(token.NewFileSet(), "example", tn, cs, kind, "k", "go-enumerator", opts); err != nil {
+		t.Errorf("generateEnumCode() = %v, want nil", err)
+	}
+}
+
+func TestFindConstantsOfTypeMissingFile(t *testing.T) {
+	fset, info, _, pkg := checkTestSource(t, `package example
+
+type Kind int
+
+const (
+	KindFirst Kind = iota // Overridden
+	KindSecond
+)
+`)
+
+	// without the syntax trees, the file of the constants can't be resolved
+	cs, _, err := findConstantsOfType(fset, info, nil, pkg.Scope().Lookup("Kind"), findOptions{NamingStrategy: snakeCase, TrimPrefix: "Kind", CommentTag: "enum", Descriptions: true, Banner: DefaultBanner})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"first", "second"}
+	for i, c := range cs {
+		if c.String != want[i] {
+			t.Errorf("%s.String = %q, want = %q", c.Name, c.String, want[i])
+		}
+	}
+}
+
+func TestFindConstantsOfTypeStrict(t *testing.T) {
+	tests := []struct {
+		name    string
+		decl    string
+		wantErr string
+	}{
+		{"valid", `Kind1 Kind = 1 // enum:"one"`, ""},
+		{"empty comment", "Kind1 Kind = 1 //", "empty string representation"},
+		{"directive", "Kind1 Kind = 1 //nolint:all", "empty string representation"},
+		{"empty tag", `Kind1 Kind = 1 // enum:"" desc:"The first kind"`, "empty string representation"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fset, info, syntax, pkg := checkTestSource(t, "package example\n\ntype Kind int\n\nconst "+tt.decl+"\n")
+			obj := pkg.Scope().Lookup("Kind")
+
+			// the same declarations are accepted without --strict
+			if _, _, err := findConstantsOfType(fset, info, syntax, obj, findOptions{CommentTag: "enum", Banner: DefaultBanner}); err != nil {
+				t.Fatal(err)
+			}
+
+			_, _, err := findConstantsOfType(fset, info, syntax, obj, findOptions{CommentTag: "enum", Strict: true, Banner: DefaultBanner})
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("findConstantsOfType() = %v, want nil", err)
+				}
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("findConstantsOfType() = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+
+	fset, info, _, pkg := checkTestSource(t, "package example\n\ntype Kind int\n\nconst Kind1 Kind = 1\n")
+	_, _, err := findConstantsOfType(fset, info, nil, pkg.Scope().Lookup("Kind"), findOptions{CommentTag: "enum", Strict: true, Banner: DefaultBanner})
+	if err == nil || !strings.Contains(err.Error(), "was not found") {
+		t.Errorf("findConstantsOfType() = %v, want error for the missing file", err)
+	}
+}
@@ -0,0 +1,791 @@
+// Package enumgen generates enum-like code for Go constants. It is the library behind the
+// go-enumerator command, so that other code generators can embed enum generation.
+//
+// Each field of [Options] corresponds to a flag of go-enumerator, which is documented in the
+// [README]. Errors refer to the options by the names of their flags, such as --flags.
+//
+// [README]: https://pkg.go.dev/github.com/a-jentleman/go-enumerator
+package enumgen
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"go/version"
+	"io"
+	"path/filepath"
+	"strings"
+)
+
+// Options selects the type to generate code for, and the code to generate.
+// The zero value of each field behaves like the default of its flag.
+type Options struct {
+	Dir     string            // directory of the package to load when Files is empty (--dir)
+	Files   []string          // files to search for the type. Line refers to the first one (--input)
+	Package string            // name of the package to load. Any package matches if it is empty (--pkg)
+	Type    string            // name of the type. If empty, the type declared after Line is used (--type)
+	Line    int               // line of the go:generate directive in the first of Files (--line)
+	Tags    string            // comma-separated build tags to consider satisfied (--tags)
+	Overlay map[string][]byte // contents of files that don't exist on disk, as with [packages.Config]
+
+	AllTypes bool   // generate code for every type declared in Files that has constants (--all-types)
+	Group    string // name of the type alias declared for the const block after Line (--group)
+
+	Receiver       string   // name of the receiver. Defaults to a name derived from the type (--receiver)
+	ReceiverStyle  string   // how the default receiver is derived, initial, short or acronym. Defaults to initial (--receiver-style)
+	NamingStrategy string   // naming strategy of the string representations. Defaults to none (--naming-strategy)
+	NamingExec     string   // command that converts constant names to string representations (--naming-exec)
+	StringFormat   string   // fmt verb that formats the values of integer enums as string representations (--string-format)
+	TrimPrefix     string   // prefix to remove from constant names (--trim-prefix)
+	AutoTrimPrefix bool     // remove the common prefix of the constant names (--auto-trim-prefix)
+	Prefix         string   // prefix to add to string representations (--prefix)
+	CommentTag     string   // key of overrides in line comments. Defaults to enum (--comment-tag)
+	Descriptions   bool     // use doc comments as descriptions (--descriptions)
+	Exclude        []string // names of constants to leave out (--exclude)
+	OnlyMarked     bool     // only use constants marked with CommentTag (--only-marked)
+	CheckBlanks    bool     // check the values skipped by blank constants (--check-blanks)
+	AllowAliases   bool     // allow constants with the same value (--allow-aliases)
+	Strict         bool     // turn warnings into errors (--strict)
+
+	OutputPkg      string   // package of the generated code, if it is not the package of the type (--output-pkg)
+	OutputTemplate string   // text/template for Enum.FileName. Defaults to {{.Type | unexported}}_enum.go (--output-template)
+	GoVersion      string   // Go version the code must compile with. Defaults to the version of the module (--go-version)
+	Banner         string   // the "Code generated" line. Defaults to DefaultBanner (--banner)
+	Header         []string // lines added as comments to the top of the generated files (--header)
+
+	// Command is the start of the command line written to the header of the generated files, such as
+	// go-enumerator --input="kind.go". The options that select the code, such as --type and --line, are
+	// added to it, so that the files can be regenerated. If empty, go-enumerator and Files are used.
+	Command string
+
+	JSON            bool    // generate MarshalJSON and UnmarshalJSON (--json)
+	MarshalStrict   bool    // return an error when marshaling undefined values as text or JSON (--marshal-strict)
+	GQLGen          bool    // generate MarshalGQL and UnmarshalGQL (--gqlgen)
+	SQL             bool    // generate Value and Scan for database/sql (--sql)
+	Slog            bool    // generate LogValue (--slog)
+	Binary          bool    // generate MarshalBinary and UnmarshalBinary (--binary)
+	YAML            string  // major version of the yaml package to generate methods for, v2 or v3 (--yaml)
+	Msgpack         string  // encoding of MarshalMsgpack and UnmarshalMsgpack, string or int (--msgpack)
+	XML             bool    // generate MarshalXML and UnmarshalXML (--xml)
+	XMLAttr         bool    // also generate MarshalXMLAttr and UnmarshalXMLAttr (--xml-attr)
+	Formatter       bool    // generate Format (--formatter)
+	CaseInsensitive bool    // parse strings regardless of their case (--case-insensitive)
+	TrimSpace       bool    // ignore leading and trailing spaces when parsing strings (--trim-space)
+	Flags           bool    // treat the values as bit flags (--flags)
+	FlagsHelpers    bool    // generate <Type>None, <Type>All and Split (--flags-helpers)
+	SentinelError   bool    // return a preallocated error when parsing fails (--sentinel-error)
+	ReceiverPointer bool    // use pointer receivers (--receiver-pointer)
+	Set             bool    // generate a <Type>Set type (--set)
+	MinMax          bool    // generate <Type>Min and <Type>Max (--min-max)
+	StringerStyle   bool    // look up string representations in a table (--stringer-style)
+	UnknownFormat   *string // text/template for undefined values. Defaults to DefaultUnknownFormat if nil (--unknown-format)
+	ProtoMaps       bool    // generate maps like the ones of protobuf enums (--proto-maps)
+	Lookup          string  // how strings are looked up, switch or map. Defaults to switch (--lookup)
+	ValuesStyle     string  // how values are listed, func or array. Defaults to func (--values-style)
+	Scan            string  // how Scan reads values, token or values. Defaults to token (--scan)
+	Compat          string  // other enum generator to be compatible with, such as enumer (--compat)
+	AcceptNumeric   bool    // also parse the underlying values (--accept-numeric)
+	Functions       bool    // generate functions instead of methods (--functions)
+	NoCompileCheck  bool    // leave out the compile check (--no-compile-check)
+	StrictCases     bool    // check that switches cover every value (--strict-cases)
+
+	// CompileCheckLimit is the total length of the values of a string enum, in bytes, above which the compile check
+	// compares each value as a whole instead of byte by byte. Defaults to DefaultCompileCheckLimit (--compile-check-limit)
+	CompileCheckLimit int
+
+	EmitJSON       bool // generate a JSON description instead of Go code (--emit-json)
+	EmitTest       bool // also generate Enum.Test (--emit-test)
+	EmitBench      bool // also generate Enum.Bench (--emit-bench)
+	EmitRandom     bool // generate <Type>Random (--emit-random)
+	EmitSort       bool // generate Less and Sort<Type>s (--emit-sort)
+	EmitDebug      bool // generate <Type>DebugString (--emit-debug)
+	EmitPredicates bool // generate an Is<Name> method for each constant (--emit-predicates)
+	EmitIter       bool // generate <Type>All, an iterator over the defined values (--emit-iter)
+
+	Log     io.Writer // receives warnings. Nothing is logged if it is nil
+	Verbose bool      // also log what was found, to diagnose why code isn't generated as expected (--verbose)
+}
+
+// Enum is the code generated for a type.
+type Enum struct {
+	Type     string // name of the type
+	Package  string // name of the package of the type
+	FileName string // name of the file for Code, following Options.OutputTemplate, in Options.Dir
+	Code     []byte // the Go code, or the JSON description with Options.EmitJSON
+	Test     []byte // the test file, with Options.EmitTest
+	Bench    []byte // the benchmark file, with Options.EmitBench
+}
+
+// NamingStrategies returns the valid values of Options.NamingStrategy.
+func NamingStrategies() []string {
+	return []string{string(none), string(camelCase), string(pascalCase), string(snakeCase), string(upperSnakeCase), string(kebabCase), string(titleCase), string(sentenceCase)}
+}
+
+// Generate returns the code for the type selected by opts, which go-enumerator would write to Enum.FileName.
+// It is a shorthand for GenerateEnums, so opts.AllTypes cannot be set.
+func Generate(opts Options) (io.Reader, error) {
+	if opts.AllTypes {
+		return nil, errors.New("--all-types cannot be used with Generate, since it generates code for several types")
+	}
+
+	enums, err := GenerateEnums(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return bytes.NewReader(enums[0].Code), nil
+}
+
+// GenerateEnums returns the code for the type selected by opts, or for every type declared in
+// opts.Files that has constants if opts.AllTypes is set. The result is only empty in that case.
+func GenerateEnums(opts Options) ([]Enum, error) {
+	log := leveledLogger{w: opts.Log, level: logNormal}
+	switch {
+	case opts.Log == nil:
+		log.level = logQuiet
+	case opts.Verbose:
+		log.level = logVerbose
+	}
+
+	if opts.NamingStrategy == "" {
+		opts.NamingStrategy = string(none)
+	}
+	if opts.CommentTag == "" {
+		opts.CommentTag = "enum"
+	}
+	if opts.ReceiverStyle == "" {
+		opts.ReceiverStyle = string(receiverInitial)
+	}
+	if opts.Lookup == "" {
+		opts.Lookup = string(lookupSwitch)
+	}
+	if opts.ValuesStyle == "" {
+		opts.ValuesStyle = string(valuesFunc)
+	}
+	if opts.Scan == "" {
+		opts.Scan = string(scanToken)
+	}
+	if opts.CompileCheckLimit == 0 {
+		opts.CompileCheckLimit = DefaultCompileCheckLimit
+	}
+	unknownFormatText := DefaultUnknownFormat
+	if opts.UnknownFormat != nil {
+		unknownFormatText = *opts.UnknownFormat
+	}
+
+	dir := opts.Dir
+	if dir != "" && len(opts.Files) > 0 {
+		return nil, errors.New("--input cannot be used with --dir")
+	}
+	if dir == "" && len(opts.Files) == 0 {
+		return nil, errors.New("failed to determine input file: --input or --dir is required")
+	}
+
+	pkg, err := loadPackage(opts.Package, opts.Files, dir, opts.Tags, opts.Overlay)
+	if err != nil {
+		return nil, err
+	}
+	pkgName := opts.Package
+	if pkgName == "" {
+		pkgName = pkg.Name
+	}
+	log.verbosef("loaded package %s (%s) with %d files", pkg.Name, pkg.PkgPath, len(pkg.Syntax))
+
+	typeName := opts.Type
+
+	if opts.Group != "" {
+		switch {
+		case !token.IsIdentifier(opts.Group):
+			return nil, fmt.Errorf("invalid --group %q: must be an identifier", opts.Group)
+		case !opts.Functions:
+			return nil, errors.New("--group requires --functions, since methods cannot be declared on the basic type of the constants")
+		case dir != "":
+			return nil, errors.New("--group cannot be used with --dir, since the const block is found by its position")
+		case opts.AllTypes:
+			return nil, errors.New("--group cannot be used with --all-types")
+		case typeName != "":
+			return nil, errors.New("--group cannot be used with --type")
+		case opts.OutputPkg != "":
+			return nil, errors.New("--group cannot be used with --output-pkg, since the type alias is declared in the package of the constants")
+		}
+	}
+
+	if dir != "" {
+		switch {
+		case opts.AllTypes:
+			return nil, errors.New("--all-types cannot be used with --dir, since there is no input file to search")
+		case opts.Line != 0:
+			return nil, errors.New("--line cannot be used with --dir, since there is no input file to resolve the position in")
+		case typeName == "":
+			return nil, errors.New("--dir requires --type, since the type cannot be found by its position")
+		}
+	}
+
+	line := opts.Line
+
+	var tns []*types.TypeName
+	var group *ast.GenDecl
+	if opts.Group != "" {
+		var basic *types.Basic
+		group, basic, err = findConstantGroup(pkg.Fset, pkg.TypesInfo, pkg.Syntax, opts.Files[0], opts.Overlay, line)
+		if err != nil {
+			return nil, err
+		}
+
+		// the constants don't have a named type, so the code is generated for the alias that is declared with it
+		tn := types.NewTypeName(group.Pos(), pkg.Types, opts.Group, nil)
+		types.NewNamed(tn, basic, nil)
+		tns = append(tns, tn)
+	} else if opts.AllTypes {
+		if typeName != "" {
+			return nil, errors.New("--type cannot be used with --all-types")
+		}
+
+		tns, err = findTypeDeclsInFiles(pkg.Fset, pkg.TypesInfo, opts.Files, opts.Overlay)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		tn, err := findTypeDecl(pkg.Fset, pkg.TypesInfo, typeName, opts.Files, opts.Overlay, line, opts.Strict)
+		if err != nil {
+			return nil, err
+		}
+
+		tns = append(tns, tn)
+	}
+
+	if opts.EmitJSON && opts.EmitTest {
+		return nil, errors.New("--emit-json cannot be used with --emit-test")
+	}
+
+	if opts.EmitJSON && opts.EmitBench {
+		return nil, errors.New("--emit-json cannot be used with --emit-bench")
+	}
+
+	if opts.XMLAttr && !opts.XML {
+		return nil, errors.New("--xml-attr requires --xml")
+	}
+
+	if opts.StringerStyle && opts.Flags {
+		return nil, errors.New("--stringer-style cannot be used with --flags")
+	}
+
+	if opts.FlagsHelpers && !opts.Flags {
+		return nil, errors.New("--flags-helpers requires --flags")
+	}
+
+	if opts.EmitIter && opts.FlagsHelpers {
+		return nil, errors.New("--emit-iter cannot be used with --flags-helpers, since both generate <type>All")
+	}
+
+	// the generated code must compile with the Go version of the module, unless another one is given
+	goVersion := pkg.Types.GoVersion()
+	if opts.GoVersion != "" {
+		goVersion, err = parseGoVersion(opts.GoVersion)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if goVersion != "" {
+		for _, r := range []struct {
+			flag    string
+			set     bool
+			version string
+		}{
+			{"--formatter", opts.Formatter, "go1.20"},
+			{"--slog", opts.Slog, "go1.21"},
+			{"--emit-iter", opts.EmitIter, "go1.23"},
+		} {
+			if r.set && version.Compare(goVersion, r.version) < 0 {
+				return nil, fmt.Errorf("%s requires Go %s or later, but the generated code must compile with %s (use --go-version to change it)", r.flag, strings.TrimPrefix(r.version, "go"), goVersion)
+			}
+		}
+	}
+
+	// a later version than the module's can only be used by toolchains that support it
+	goConstraint := ""
+	if opts.GoVersion != "" && version.Compare(version.Lang(goVersion), version.Lang(pkg.Types.GoVersion())) > 0 {
+		goConstraint = version.Lang(goVersion)
+	}
+
+	banner := strings.TrimSpace(strings.TrimPrefix(opts.Banner, "//"))
+	if banner == "" {
+		banner = DefaultBanner
+	}
+	if strings.ContainsAny(banner, "\r\n") {
+		return nil, errors.New("--banner must be a single line of text")
+	}
+
+	outputTemplate, err := parseOutputTemplate(opts.OutputTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	outputPkg := opts.OutputPkg
+	if outputPkg == pkgName {
+		outputPkg = ""
+	}
+
+	if outputPkg != "" && !opts.Functions {
+		return nil, fmt.Errorf("methods cannot be declared on types outside of their package, so --output-pkg=%s requires --functions to generate functions instead of methods", outputPkg)
+	}
+
+	if opts.Functions {
+		switch {
+		case opts.JSON:
+			return nil, errors.New("--json cannot be used with --functions")
+		case opts.MarshalStrict:
+			return nil, errors.New("--marshal-strict cannot be used with --functions")
+		case opts.GQLGen:
+			return nil, errors.New("--gqlgen cannot be used with --functions")
+		case opts.SQL:
+			return nil, errors.New("--sql cannot be used with --functions")
+		case opts.Slog:
+			return nil, errors.New("--slog cannot be used with --functions")
+		case opts.Binary:
+			return nil, errors.New("--binary cannot be used with --functions")
+		case opts.YAML != "":
+			return nil, errors.New("--yaml cannot be used with --functions")
+		case opts.Msgpack != "":
+			return nil, errors.New("--msgpack cannot be used with --functions")
+		case opts.XML:
+			return nil, errors.New("--xml cannot be used with --functions")
+		case opts.Formatter:
+			return nil, errors.New("--formatter cannot be used with --functions")
+		case opts.EmitTest:
+			return nil, errors.New("--emit-test cannot be used with --functions")
+		case opts.EmitBench:
+			return nil, errors.New("--emit-bench cannot be used with --functions")
+		case opts.EmitJSON:
+			return nil, errors.New("--emit-json cannot be used with --functions")
+		case opts.ReceiverPointer:
+			return nil, errors.New("--receiver-pointer cannot be used with --functions")
+		}
+	}
+
+	if _, ok := yamlPackages[yamlVersion(opts.YAML)]; opts.YAML != "" && !ok {
+		return nil, fmt.Errorf("invalid --yaml %q: must be v2 or v3", opts.YAML)
+	}
+
+	switch msgpackFormat(opts.Msgpack) {
+	case msgpackNone, msgpackString, msgpackInt:
+	default:
+		return nil, fmt.Errorf("invalid --msgpack %q: must be string or int", opts.Msgpack)
+	}
+
+	switch receiverStyle(opts.ReceiverStyle) {
+	case receiverInitial, receiverShort, receiverAcronym:
+	default:
+		return nil, fmt.Errorf("invalid --receiver-style %q: must be initial, short or acronym", opts.ReceiverStyle)
+	}
+
+	switch lookupStrategy(opts.Lookup) {
+	case lookupSwitch, lookupMap:
+	default:
+		return nil, fmt.Errorf("invalid --lookup %q: must be switch or map", opts.Lookup)
+	}
+
+	switch compatMode(opts.Compat) {
+	case compatNone:
+	case compatEnumer:
+		// enumer's names for these functions are taken by the ones generated in these modes
+		if opts.Functions {
+			return nil, errors.New("--compat=enumer cannot be used with --functions, since <type>String would be declared twice")
+		}
+
+		if valuesStyle(opts.ValuesStyle) == valuesArray {
+			return nil, errors.New("--compat=enumer cannot be used with --values-style=array, since enumer declares <type>Values as a function")
+		}
+	default:
+		return nil, fmt.Errorf("invalid --compat %q: must be enumer", opts.Compat)
+	}
+
+	if opts.AutoTrimPrefix && opts.TrimPrefix != "" {
+		return nil, errors.New("--auto-trim-prefix cannot be used with --trim-prefix")
+	}
+
+	var namer *namingExec
+	if opts.NamingExec != "" {
+		if namingStrategyName(opts.NamingStrategy) != none {
+			return nil, errors.New("--naming-exec cannot be used with --naming-strategy")
+		}
+
+		namer, err = newNamingExec(opts.NamingExec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if opts.StringFormat != "" {
+		for _, r := range []struct {
+			flag string
+			set  bool
+		}{
+			{"--naming-strategy", namingStrategyName(opts.NamingStrategy) != none},
+			{"--naming-exec", opts.NamingExec != ""},
+			{"--trim-prefix", opts.TrimPrefix != ""},
+			{"--auto-trim-prefix", opts.AutoTrimPrefix},
+		} {
+			if r.set {
+				return nil, fmt.Errorf("--string-format cannot be used with %s, since the names of the constants are not used", r.flag)
+			}
+		}
+
+		if err := checkStringFormat(opts.StringFormat); err != nil {
+			return nil, err
+		}
+	}
+
+	if opts.CompileCheckLimit < 0 {
+		return nil, fmt.Errorf("invalid --compile-check-limit %d: must be positive", opts.CompileCheckLimit)
+	}
+
+	unknownFormat, err := parseUnknownFormat(unknownFormatText)
+	if err != nil {
+		return nil, err
+	}
+
+	switch valuesStyle(opts.ValuesStyle) {
+	case valuesFunc, valuesArray:
+	default:
+		return nil, fmt.Errorf("invalid --values-style %q: must be func or array", opts.ValuesStyle)
+	}
+
+	switch scanStrategy(opts.Scan) {
+	case scanToken:
+	case scanValues:
+		if opts.SQL {
+			return nil, errors.New("--scan=values cannot be used with --sql, since the fmt.Scanner implementation is not generated")
+		}
+	default:
+		return nil, fmt.Errorf("invalid --scan %q: must be token or values", opts.Scan)
+	}
+
+	reproCmd := opts.Command
+	if reproCmd == "" {
+		reproCmd = "go-enumerator"
+		if len(opts.Files) > 0 {
+			reproCmd = fmt.Sprintf("%s --input=%q", reproCmd, strings.Join(opts.Files, ","))
+		}
+	}
+
+	if dir != "" {
+		reproCmd = fmt.Sprintf("%s --dir=%q --type=%q", reproCmd, dir, typeName)
+	}
+
+	reproCmd = fmt.Sprintf("%s --pkg=%q", reproCmd, pkgName)
+
+	if line > 0 {
+		reproCmd = fmt.Sprintf("%s --line=%d", reproCmd, line)
+	}
+
+	if opts.AllTypes {
+		reproCmd = fmt.Sprintf("%s --all-types", reproCmd)
+	}
+
+	if opts.Functions {
+		reproCmd = fmt.Sprintf("%s --functions", reproCmd)
+	}
+
+	if opts.Group != "" {
+		reproCmd = fmt.Sprintf("%s --group=%q", reproCmd, opts.Group)
+	}
+
+	if outputPkg != "" {
+		reproCmd = fmt.Sprintf("%s --output-pkg=%q", reproCmd, outputPkg)
+	}
+
+	if opts.Tags != "" {
+		reproCmd = fmt.Sprintf("%s --tags=%q", reproCmd, opts.Tags)
+	}
+
+	gopts := generateOptions{
+		JSON:   opts.JSON,
+		GQLGen: opts.GQLGen,
+		SQL:    opts.SQL,
+		Slog:   opts.Slog,
+
+		MarshalStrict: opts.MarshalStrict,
+
+		Binary: opts.Binary,
+
+		Formatter: opts.Formatter,
+
+		CaseInsensitive: opts.CaseInsensitive,
+		TrimSpace:       opts.TrimSpace,
+
+		// encoding.TextAppender was added in Go 1.24
+		TextAppender: version.Compare(goVersion, "go1.24") >= 0,
+
+		Flags:        opts.Flags,
+		FlagsHelpers: opts.FlagsHelpers,
+
+		SentinelError: opts.SentinelError,
+
+		ReceiverPointer: opts.ReceiverPointer,
+
+		Set: opts.Set,
+
+		MinMax: opts.MinMax,
+
+		StringerStyle: opts.StringerStyle,
+		UnknownFormat: unknownFormat,
+
+		ProtoMaps: opts.ProtoMaps,
+
+		Random: opts.EmitRandom,
+		Sort:   opts.EmitSort,
+		Debug:  opts.EmitDebug,
+
+		Predicates: opts.EmitPredicates,
+
+		Iter: opts.EmitIter,
+
+		NoCompileCheck:    opts.NoCompileCheck,
+		CompileCheckLimit: opts.CompileCheckLimit,
+		StrictCases:       opts.StrictCases,
+		AllowAliases:      opts.AllowAliases,
+
+		Lookup: lookupStrategy(opts.Lookup),
+		Values: valuesStyle(opts.ValuesStyle),
+		Scan:   scanStrategy(opts.Scan),
+		Strict: opts.Strict,
+
+		AcceptNumeric: opts.AcceptNumeric,
+
+		Compat: compatMode(opts.Compat),
+
+		YAML: yamlVersion(opts.YAML),
+
+		Msgpack: msgpackFormat(opts.Msgpack),
+
+		XML:     opts.XML,
+		XMLAttr: opts.XMLAttr,
+
+		Functions: opts.Functions,
+		OutputPkg: outputPkg,
+		Group:     group != nil,
+
+		Banner: banner,
+		Header: headerComments(opts.Header),
+
+		log: log,
+	}
+
+	if err := warn(checkBanner(banner), gopts); err != nil {
+		return nil, err
+	}
+
+	for _, name := range opts.Exclude {
+		if _, ok := pkg.Types.Scope().Lookup(name).(*types.Const); !ok {
+			return nil, fmt.Errorf("--exclude %s: no constant named %s found in package %s", name, name, pkgName)
+		}
+	}
+
+	gopts.Generated = generatedFiles(pkg.Syntax, banner)
+
+	fopts := findOptions{
+		CommentTag: opts.CommentTag,
+		Exclude:    opts.Exclude,
+		OnlyMarked: opts.OnlyMarked,
+		Strict:     opts.Strict,
+		Banner:     banner,
+	}
+
+	findConstants := func(tn *types.TypeName, fopts findOptions) ([]constNameAndString, constant.Kind, error) {
+		if group != nil {
+			return findConstantsInGroup(pkg.Fset, pkg.TypesInfo, pkg.Syntax, group, fopts)
+		}
+
+		return findConstantsOfType(pkg.Fset, pkg.TypesInfo, pkg.Syntax, tn, fopts)
+	}
+
+	var ret []Enum
+	for _, tn := range tns {
+		log.verbosef("%s: found type %s", pkg.Fset.Position(tn.Pos()), tn.Name())
+
+		trimPrefix := opts.TrimPrefix
+		if opts.AutoTrimPrefix {
+			// the prefix depends on the names of all the constants, so they are collected first
+			vs, _, err := findConstants(tn, fopts)
+			if err != nil {
+				return nil, err
+			}
+
+			var names []string
+			for _, c := range vs {
+				names = append(names, c.Name)
+			}
+
+			trimPrefix = commonWordPrefix(names)
+			log.verbosef("trimming the common prefix %q of the constants of type %s", trimPrefix, tn.Name())
+		}
+
+		fopts := fopts
+		fopts.NamingStrategy = namingStrategyName(opts.NamingStrategy)
+		fopts.TrimPrefix = trimPrefix
+		fopts.Prefix = opts.Prefix
+		fopts.Descriptions = opts.Descriptions
+
+		vs, kind, err := findConstants(tn, fopts)
+		if err != nil {
+			return nil, err
+		}
+
+		if namer != nil {
+			if err := namer.apply(vs, trimPrefix, opts.Prefix); err != nil {
+				return nil, err
+			}
+		}
+
+		if opts.StringFormat != "" {
+			if err := applyStringFormat(tn, vs, opts.StringFormat, opts.Prefix); err != nil {
+				return nil, err
+			}
+		}
+
+		for _, c := range vs {
+			log.verbosef("%s: found constant %s = %s with string representation %q", pkg.Fset.Position(c.Const.Pos()), c.Name, c.Const.Val(), c.String)
+		}
+
+		if opts.CheckBlanks {
+			gopts.Blanks = findBlankConstantsOfType(pkg.Fset, pkg.TypesInfo, tn)
+		}
+
+		gopts.Excluded = findExcludedConstantsOfType(pkg.Fset, pkg.TypesInfo, tn, opts.Exclude)
+
+		// the generated code is only valid where the type is declared
+		gopts.BuildConstraint = findBuildConstraint(findAstFileForToken(tn.Pos(), pkg.Syntax))
+		if goConstraint != "" {
+			gopts.BuildConstraint, err = addBuildConstraint(gopts.BuildConstraint, goConstraint)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if len(vs) == 0 {
+			log.verbosef("no constants of type %s found in the files of package %s: %s", tn.Name(), pkg.PkgPath, strings.Join(fileNames(pkg.Fset, pkg.Syntax), ", "))
+			if opts.AllTypes {
+				// not every type in the file is meant to be an enum
+				continue
+			}
+			return nil, noConstantsError(pkg.Fset, pkg.TypesInfo, tn, opts.Files, opts.Overlay)
+		}
+
+		if outputPkg != "" {
+			if err := checkExported(tn, vs); err != nil {
+				return nil, err
+			}
+		}
+
+		e := Enum{Type: tn.Name(), Package: pkgName}
+		e.FileName, err = executeOutputTemplate(outputTemplate, tn, pkgName)
+		if err != nil {
+			return nil, err
+		}
+
+		if dir != "" {
+			e.FileName = filepath.Join(dir, e.FileName)
+		}
+
+		if opts.EmitJSON {
+			e.FileName = strings.TrimSuffix(e.FileName, ".go") + ".json"
+			e.Code, err = generateEnumJSON(pkgName, tn, vs)
+			if err != nil {
+				return nil, err
+			}
+
+			ret = append(ret, e)
+			continue
+		}
+
+		receiver := opts.Receiver
+		if receiver == "" {
+			receiver = defaultReceiverName(tn, receiverStyle(opts.ReceiverStyle))
+		}
+		receiver = safeIndent(receiver)
+
+		f, err := generateEnumCode(pkg.Fset, pkgName, tn, vs, kind, receiver, reproCmd, gopts)
+		if err != nil {
+			return nil, err
+		}
+
+		// the name is only used to resolve imports, which are all qualified, so the file of the type will do
+		name := pkg.Fset.Position(tn.Pos()).Filename
+		e.Code, err = renderOutput(f, name)
+		if err != nil {
+			return nil, err
+		}
+
+		if opts.EmitTest {
+			e.Test, err = renderOutput(generateEnumTest(pkgName, tn, vs, reproCmd, gopts), name)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if opts.EmitBench {
+			e.Bench, err = renderOutput(generateEnumBench(pkgName, tn, vs, reproCmd, gopts), name)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		ret = append(ret, e)
+	}
+
+	return ret, nil
+}
+
+// logLevel selects the messages printed by a leveledLogger.
+type logLevel int
+
+const (
+	logQuiet   logLevel = iota // only errors, which are returned instead of logged
+	logNormal                  // warnings
+	logVerbose                 // what was found, to diagnose generation
+)
+
+// leveledLogger prints messages up to level to w.
+type leveledLogger struct {
+	w     io.Writer
+	level logLevel
+}
+
+// warnf prints a warning, unless --quiet is set.
+func (l leveledLogger) warnf(format string, args ...any) {
+	l.printf(logNormal, "warning: "+format, args...)
+}
+
+// verbosef prints a message if --verbose is set.
+func (l leveledLogger) verbosef(format string, args ...any) {
+	l.printf(logVerbose, format, args...)
+}
+
+func (l leveledLogger) printf(level logLevel, format string, args ...any) {
+	if level > l.level || l.w == nil {
+		return
+	}
+
+	fmt.Fprintf(l.w, format+"\n", args...)
+}
+
+// warn prints err as a warning and returns nil, or returns err if opts.Strict is set.
+// It returns nil if err is nil.
+func warn(err error, opts generateOptions) error {
+	if err == nil {
+		return nil
+	}
+
+	if opts.Strict {
+		return fmt.Errorf("%w (--strict)", err)
+	}
+
+	opts.log.warnf("%v", err)
+	return nil
+}
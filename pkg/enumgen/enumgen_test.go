@@ -0,0 +1,170 @@
+package enumgen
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io"
+	"strings"
+	"testing"
+)
+
+// checkTestSource parses and type-checks src as the file example.go.
+// Type errors are ignored, since the tool can be run on code that does not compile.
+func checkTestSource(t *testing.T, src string) (*token.FileSet, *types.Info, []*ast.File, *types.Package) {
+	t.Helper()
+
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "example.go", src, parser.ParseComments)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	info := &types.Info{Defs: make(map[*ast.Ident]types.Object)}
+	conf := types.Config{Error: func(error) {}}
+	pkg, _ := conf.Check("example", fset, []*ast.File{f}, info)
+
+	return fset, info, []*ast.File{f}, pkg
+}
+
+// newTestEnum returns a type named name with the underlying type basic,
+// along with a constant of that type for each entry in values.
+func newTestEnum(name string, basic types.BasicKind, names []string, values []any) (*types.TypeName, []constNameAndString, constant.Kind) {
+	pkg := types.NewPackage("example", "example")
+	tn := types.NewTypeName(token.NoPos, pkg, name, nil)
+	named := types.NewNamed(tn, types.Typ[basic], nil)
+
+	var cs []constNameAndString
+	for i, n := range names {
+		c := types.NewConst(token.NoPos, pkg, n, named, constant.Make(values[i]))
+		cs = append(cs, constNameAndString{Const: c, Name: n, String: n})
+	}
+
+	return tn, cs, cs[0].Const.Val().Kind()
+}
+
+// renderTestEnum generates the code for the enum returned by newTestEnum using opts.
+func renderTestEnum(t *testing.T, tn *types.TypeName, cs []constNameAndString, kind constant.Kind, opts generateOptions) string {
+	t.Helper()
+
+	f, err := generateEnumCode(token.NewFileSet(), "example", tn, cs, kind, defaultReceiverName(tn, receiverInitial), "go-enumerator", opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := f.Render(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	return buf.String()
+}
+
+// containsCode reports whether want is in got, ignoring differences in whitespace.
+func containsCode(got, want string) bool {
+	return strings.Contains(strings.Join(strings.Fields(got), " "), strings.Join(strings.Fields(want), " "))
+}
+
+func TestLeveledLogger(t *testing.T) {
+	tests := []struct {
+		level logLevel
+		want  string
+	}{
+		{logQuiet, ""},
+		{logNormal, "warning: w\n"},
+		{logVerbose, "warning: w\nv\n"},
+	}
+
+	for _, tt := range tests {
+		var buf bytes.Buffer
+		l := leveledLogger{w: &buf, level: tt.level}
+		l.warnf("%s", "w")
+		l.verbosef("%s", "v")
+
+		if got := buf.String(); got != tt.want {
+			t.Errorf("level %d logged %q, want = %q", tt.level, got, tt.want)
+		}
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	r, err := Generate(Options{Dir: "../../example", Type: "Kind", JSON: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	src, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := string(src)
+	for _, want := range []string{
+		"package example",
+		"// " + DefaultBanner,
+		`// Command: go-enumerator --dir="../../example" --type="Kind" --pkg="example"`,
+		"func (k Kind) String() string {",
+		"func (k Kind) MarshalJSON() ([]byte, error) {",
+		"// Re-run the go-enumerator command to generate them again.",
+	} {
+		if !containsCode(got, want) {
+			t.Errorf("generated code does not contain %q:\n%s", want, got)
+		}
+	}
+
+	// the compile check names the command that the header starts with
+	r, err = Generate(Options{Dir: "../../example", Type: "Kind", Command: "enumgen"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if src, err = io.ReadAll(r); err != nil {
+		t.Fatal(err)
+	}
+
+	if want := "// Re-run the enumgen command to generate them again."; !containsCode(string(src), want) {
+		t.Errorf("generated code does not contain %q:\n%s", want, src)
+	}
+
+	if _, err := Generate(Options{Dir: "../../example", Type: "Kind", AllTypes: true}); err == nil {
+		t.Error("Generate() with AllTypes = nil, want error")
+	}
+
+	if _, err := Generate(Options{Dir: "../../example", Type: "Kind", Lookup: "tree"}); err == nil || err.Error() != `invalid --lookup "tree": must be switch or map` {
+		t.Errorf("Generate() with an invalid Lookup = %v, want error", err)
+	}
+}
+
+func TestGenerateEnums(t *testing.T) {
+	var log bytes.Buffer
+	enums, err := GenerateEnums(Options{
+		Files:    []string{"../../example/shape.go"},
+		AllTypes: true,
+		EmitTest: true,
+		Banner:   "Code generated by enumgen.",
+		Log:      &log,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, e := range enums {
+		got = append(got, e.Type+" "+e.Package+" "+e.FileName)
+		if e.Test == nil || e.Bench != nil {
+			t.Errorf("GenerateEnums() for %s returned Test %t and Bench %t, want Test only", e.Type, e.Test != nil, e.Bench != nil)
+		}
+	}
+
+	if want := []string{"Shape example shape_enum.go", "Size example size_enum.go"}; fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("GenerateEnums() = %q, want = %q", got, want)
+	}
+
+	if !strings.HasPrefix(log.String(), `warning: --banner "Code generated by enumgen." does not match`) {
+		t.Errorf("GenerateEnums() logged %q, want banner warning", log.String())
+	}
+}
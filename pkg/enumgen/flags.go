@@ -0,0 +1,150 @@
+package enumgen
+
+import (
+	"go/constant"
+	"go/types"
+
+	"github.com/dave/jennifer/jen"
+)
+
+// isSingleFlag returns true if c holds a single bit.
+func isSingleFlag(c constNameAndString) bool {
+	v, _ := constant.Uint64Val(c.Const.Val())
+	return v != 0 && v&(v-1) == 0
+}
+
+// generateFlagsStringMethod generates the String() method for bit flag enums.
+// Combinations of flags are joined with "|".
+func generateFlagsStringMethod(f *jen.File, receiver string, eType *types.TypeName, basic *types.Basic, cs []constNameAndString, partsVarName string, opts generateOptions) {
+	if opts.Functions {
+		f.Commentf("%s returns the string representation of %s. Combinations of flags are joined with \"|\". If !%s, then a generated string is returned based on %s's value.", methodName(eType, "String", opts), receiver, methodCall(receiver, eType, "Defined", opts).GoString(), receiver)
+	} else {
+		f.Commentf("String implements [fmt.Stringer]. Combinations of flags are joined with \"|\". If !%s.Defined(), then a generated string is returned based on %s's value.", receiver, receiver)
+	}
+
+	methodDecl(f, receiverParam(receiver, eType, opts), eType, "String", opts).String().BlockFunc(func(g *jen.Group) {
+		g.Switch(receiverValue(receiver, opts)).BlockFunc(func(g *jen.Group) {
+			for _, c := range cs {
+				g.Case(constRef(c)).Block(jen.Return(jen.Lit(c.String)))
+			}
+		})
+		g.If(jen.Op("!").Add(methodCall(receiver, eType, "Defined", opts))).Block(
+			jen.Return(fallbackString(eType, basic, receiverValue(receiver, opts), opts)),
+		)
+
+		g.Line()
+		g.Var().Id(partsVarName).Index().String()
+		for _, c := range cs {
+			if !isSingleFlag(c) {
+				continue
+			}
+			g.If(receiverValue(receiver, opts).Op("&").Add(constRef(c)).Op("!=").Lit(0)).Block(
+				jen.Id(partsVarName).Op("=").Append(jen.Id(partsVarName), jen.Lit(c.String)),
+			)
+		}
+		g.Return(jen.Qual("strings", "Join").Call(jen.Id(partsVarName), jen.Lit("|")))
+	})
+}
+
+// generateFlagsDefinedMethod generates the Defined() method for bit flag enums.
+// Any combination of defined flags is considered defined, including the empty one.
+func generateFlagsDefinedMethod(f *jen.File, receiver string, tn *types.TypeName, cs []constNameAndString, opts generateOptions) {
+	var mask *jen.Statement
+	for _, c := range cs {
+		switch {
+		case constant.Sign(c.Const.Val()) == 0:
+		case mask == nil:
+			mask = constRef(c)
+		default:
+			mask = mask.Op("|").Add(constRef(c))
+		}
+	}
+
+	if mask == nil {
+		mask = jen.Lit(0)
+	}
+
+	f.Commentf("%s returns true if %s holds no flags, a defined flag, or a combination of defined flags.", methodName(tn, "Defined", opts), receiver)
+	methodDecl(f, receiverParam(receiver, tn, opts), tn, "Defined", opts).Bool().Block(
+		jen.Return(receiverValue(receiver, opts).Op("&^").Parens(mask).Op("==").Lit(0)),
+	)
+}
+
+// generateHasMethod generates the Has() method for bit flag enums.
+func generateHasMethod(f *jen.File, receiver string, tn *types.TypeName, otherVarName string, opts generateOptions) {
+	f.Commentf("%s returns true if all flags set in %s are also set in %s.", methodName(tn, "Has", opts), otherVarName, receiver)
+	methodDecl(f, jen.Id(receiver).Add(typeRef(tn)), tn, "Has", opts, jen.Id(otherVarName).Add(typeRef(tn))).Bool().Block(
+		jen.Return(jen.Id(receiver).Op("&").Id(otherVarName).Op("==").Id(otherVarName)),
+	)
+}
+
+// generateSetMethod generates the Set() method for bit flag enums.
+func generateSetMethod(f *jen.File, receiver string, tn *types.TypeName, otherVarName string, opts generateOptions) {
+	f.Commentf("%s returns a copy of %s with the flags in %s set.", methodName(tn, "Set", opts), receiver, otherVarName)
+	methodDecl(f, jen.Id(receiver).Add(typeRef(tn)), tn, "Set", opts, jen.Id(otherVarName).Add(typeRef(tn))).Add(typeRef(tn)).Block(
+		jen.Return(jen.Id(receiver).Op("|").Id(otherVarName)),
+	)
+}
+
+// generateClearMethod generates the Clear() method for bit flag enums.
+func generateClearMethod(f *jen.File, receiver string, tn *types.TypeName, otherVarName string, opts generateOptions) {
+	f.Commentf("%s returns a copy of %s with the flags in %s cleared.", methodName(tn, "Clear", opts), receiver, otherVarName)
+	methodDecl(f, jen.Id(receiver).Add(typeRef(tn)), tn, "Clear", opts, jen.Id(otherVarName).Add(typeRef(tn))).Add(typeRef(tn)).Block(
+		jen.Return(jen.Id(receiver).Op("&^").Id(otherVarName)),
+	)
+}
+
+// singleFlags returns the constants in cs whose values are a single bit, leaving out combinations of flags.
+func singleFlags(cs []constNameAndString) []constNameAndString {
+	var ret []constNameAndString
+	for _, c := range cs {
+		if v, ok := constant.Uint64Val(c.Const.Val()); ok && v != 0 && v&(v-1) == 0 {
+			ret = append(ret, c)
+		}
+	}
+
+	return ret
+}
+
+// generateSplitMethod generates the Split() method for bit flag enums.
+func generateSplitMethod(f *jen.File, receiver string, tn *types.TypeName, cs []constNameAndString, opts generateOptions) {
+	flags := singleFlags(cs)
+	flagsVarName := safeIndent("flags", receiver)
+	flagVarName := safeIndent("flag", receiver, flagsVarName)
+
+	f.Commentf("%s returns the single flags set in %s in the order they are declared. Combinations of flags and bits that are not defined are left out.", methodName(tn, "Split", opts), receiver)
+	methodDecl(f, jen.Id(receiver).Add(typeRef(tn)), tn, "Split", opts).Index().Add(typeRef(tn)).Block(
+		jen.Var().Id(flagsVarName).Index().Add(typeRef(tn)),
+		jen.For(jen.List(jen.Id("_"), jen.Id(flagVarName)).Op(":=").Range().Index().Add(typeRef(tn)).ValuesFunc(func(g *jen.Group) {
+			for _, c := range flags {
+				g.Add(constRef(c))
+			}
+		})).Block(
+			jen.If(jen.Id(receiver).Op("&").Id(flagVarName).Op("!=").Lit(0)).Block(
+				jen.Id(flagsVarName).Op("=").Append(jen.Id(flagsVarName), jen.Id(flagVarName)),
+			),
+		),
+		jen.Return(jen.Id(flagsVarName)),
+	)
+}
+
+// generateFlagsConstants generates the <Type>None and <Type>All constants for bit flag enums.
+// <Type>All is the combination of every single flag in cs.
+func generateFlagsConstants(f *jen.File, tn *types.TypeName, cs []constNameAndString) {
+	all := jen.Lit(0)
+	if flags := singleFlags(cs); len(flags) > 0 {
+		all = jen.CustomFunc(jen.Options{Separator: " | "}, func(g *jen.Group) {
+			for _, c := range flags {
+				g.Add(constRef(c))
+			}
+		})
+	}
+
+	f.Const().Defs(
+		jen.Commentf("%sNone holds no flags", tn.Name()),
+		jen.Id(tn.Name()+"None").Add(typeRef(tn)).Op("=").Lit(0),
+		jen.Line(),
+		jen.Commentf("%sAll holds every defined flag", tn.Name()),
+		jen.Id(tn.Name()+"All").Add(typeRef(tn)).Op("=").Add(all),
+	)
+}
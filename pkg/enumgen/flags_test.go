@@ -0,0 +1,47 @@
+package enumgen
+
+import (
+	"go/types"
+	"strings"
+	"testing"
+)
+
+func TestGenerateFlagsHelpers(t *testing.T) {
+	tn, cs, kind := newTestEnum("Permission", types.Uint8, []string{"PermissionRead", "PermissionWrite", "PermissionReadWrite", "PermissionExecute"}, []any{int64(1), int64(2), int64(3), int64(4)})
+	got := renderTestEnum(t, tn, cs, kind, generateOptions{Flags: true, FlagsHelpers: true})
+	for _, want := range []string{
+		"PermissionNone Permission = 0",
+		"PermissionAll Permission = PermissionRead | PermissionWrite | PermissionExecute",
+		"func (p Permission) Split() []Permission {",
+		"for _, flag := range []Permission{PermissionRead, PermissionWrite, PermissionExecute} {",
+	} {
+		if !containsCode(got, want) {
+			t.Errorf("generated code does not contain %q:\n%s", want, got)
+		}
+	}
+
+	if got := renderTestEnum(t, tn, cs, kind, generateOptions{Flags: true}); containsCode(got, "PermissionAll") {
+		t.Errorf("generated code contains PermissionAll without --flags-helpers:\n%s", got)
+	}
+
+	// constants declared by hand collide with the generated ones
+	fset, info, syntax, pkg := checkTestSource(t, `package example
+
+type Permission uint8
+
+const (
+	PermissionRead Permission = 1 << iota
+	PermissionWrite
+	PermissionAll = PermissionRead | PermissionWrite
+)
+`)
+	obj := pkg.Scope().Lookup("Permission")
+	cs, kind, err := findConstantsOfType(fset, info, syntax, obj, findOptions{CommentTag: "enum", Exclude: []string{"PermissionAll"}, Banner: DefaultBanner})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := generateEnumCode(fset, "example", obj.(*types.TypeName), cs, kind, "p", "go-enumerator", generateOptions{Flags: true, FlagsHelpers: true}); err == nil || !strings.Contains(err.Error(), "PermissionAll") {
+		t.Errorf("generateEnumCode() = %v, want error for PermissionAll", err)
+	}
+}
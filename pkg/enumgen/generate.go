@@ -0,0 +1,1000 @@
+package enumgen
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/build/constraint"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"strings"
+	"text/template"
+
+	"github.com/dave/jennifer/jen"
+)
+
+// addBuildConstraint returns the //go:build line line with tag added to it, so that both must be satisfied.
+// line may be empty, in which case only tag is required.
+func addBuildConstraint(line, tag string) (string, error) {
+	var expr constraint.Expr = &constraint.TagExpr{Tag: tag}
+	if line != "" {
+		x, err := constraint.Parse(line)
+		if err != nil {
+			return "", err
+		}
+		expr = &constraint.AndExpr{X: x, Y: expr}
+	}
+
+	return "//go:build " + expr.String(), nil
+}
+
+// generateOptions holds the optional features to include in the generated code.
+type generateOptions struct {
+	JSON bool // generate MarshalJSON and UnmarshalJSON
+
+	MarshalStrict bool // MarshalText, AppendText and MarshalJSON return an error for undefined values
+
+	GQLGen bool // generate MarshalGQL and UnmarshalGQL
+	SQL    bool // generate Value and Scan for database/sql instead of Scan for fmt
+	Slog   bool // generate LogValue
+
+	Binary bool // generate MarshalBinary and UnmarshalBinary
+
+	Formatter bool // generate Format
+
+	TextAppender bool // generate AppendText
+
+	CaseInsensitive bool // parse strings regardless of their case
+	TrimSpace       bool // remove leading and trailing spaces before parsing strings
+
+	Flags        bool // values are bit flags that can be combined
+	FlagsHelpers bool // generate <Type>None, <Type>All and Split for bit flags
+
+	SentinelError bool // return Err<Type> instead of allocating an Invalid<Type>Error when parsing fails
+
+	ReceiverPointer bool // use pointer receivers for String, Bytes, Defined, Next and Prev
+
+	Set bool // generate a <Type>Set type
+
+	MinMax bool // generate <Type>Min and <Type>Max constants for numeric enums
+
+	ProtoMaps bool // generate <Type>NameMap and <Type>ValueMap like the maps of protobuf enums
+
+	Random bool // generate <Type>Random for property tests
+
+	Sort bool // generate Less and Sort<Types> to order values by their declaration
+
+	Debug bool // generate <Type>DebugString to log every constant
+
+	Predicates bool // generate an Is<Name> method for each constant
+
+	Iter bool // generate <Type>All, a range-over-func iterator over the values
+
+	StringerStyle bool               // look up the string representations of integer enums with values 0 to n-1 in a table
+	UnknownFormat *template.Template // string representation of undefined values. Type(value) is used if nil
+
+	BuildConstraint string // //go:build line of the file declaring the enum, if any
+
+	Generated []*ast.File // files generated by go-enumerator, whose declarations are replaced
+
+	Header []string // comment lines to add before the "Code generated" line
+	Banner string   // the "Code generated" line, without the comment marker. DefaultBanner is used if empty
+
+	NoCompileCheck bool // omit the _() function that guards against changed constants
+	StrictCases    bool // generate a _() function with a switch over every value, which guards against added constants
+	AllowAliases   bool // allow multiple constants with the same value
+
+	CompileCheckLimit int // total length of string values above which they are compared as a whole in the compile check, if set
+
+	Blanks []*types.Const // blank constants that skip values, which must not be reused
+
+	Excluded []constNameAndString // constants left out of the enum, which are only part of the compile check
+
+	Lookup lookupStrategy // how strings are looked up when parsing
+	Values valuesStyle    // whether <Type>Values is a function or an array
+	Scan   scanStrategy   // how Scan reads the string to parse
+	Strict bool           // return an error instead of printing warnings
+
+	AcceptNumeric bool // also parse integer enums from their underlying values written as integers
+
+	Compat compatMode // generate the symbols of another enum generator, if set
+
+	YAML yamlVersion // generate MarshalYAML and UnmarshalYAML for this version of the yaml package, if set
+
+	Msgpack msgpackFormat // generate MarshalMsgpack and UnmarshalMsgpack with this encoding, if set
+
+	XML     bool // generate MarshalXML and UnmarshalXML
+	XMLAttr bool // generate MarshalXMLAttr and UnmarshalXMLAttr
+
+	Functions bool   // generate functions instead of methods
+	OutputPkg string // package name of the generated file, if different from the enum's package
+	Group     bool   // declare the enum as an alias of the basic type of its constants, which don't have a named type
+
+	log leveledLogger // prints the warnings that Strict turns into errors
+}
+
+// generateEnumCode generates the code to turn tn into an enum.
+// fset is used to report the positions of constants that collide.
+func generateEnumCode(fset *token.FileSet, pkgName string, tn *types.TypeName, cs []constNameAndString, kind constant.Kind, receiver string, reproCmd string, opts generateOptions) (f *jen.File, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			f = nil
+			err = r.(error)
+		}
+	}()
+
+	// the receiver must not shadow the type, its constants, or the identifiers used by the generated methods
+	used := append([]string{tn.Name()}, reservedIdents...)
+	for _, c := range cs {
+		used = append(used, c.Name)
+	}
+	receiver = safeIndent(receiver, used...)
+	tokenVarName := safeIndent("token", receiver)
+	stringVarName := safeIndent("str", receiver, tokenVarName)
+	scanStateVarName := safeIndent("scanState", receiver, tokenVarName, stringVarName)
+	verbVarName := safeIndent("verb", receiver, tokenVarName, stringVarName, scanStateVarName)
+	xVarName := safeIndent("x", receiver, tokenVarName, stringVarName, scanStateVarName, verbVarName)
+	bVarName := safeIndent("b", receiver)
+	srcVarName := safeIndent("src", receiver)
+	vVarName := safeIndent("v", receiver, tokenVarName, xVarName)
+	okVarName := safeIndent("ok", receiver, tokenVarName, xVarName, vVarName)
+	otherVarName := safeIndent("other", receiver)
+	partsVarName := safeIndent("parts", receiver)
+	partVarName := safeIndent("part", receiver, tokenVarName, stringVarName, xVarName, vVarName, okVarName)
+	flagVarName := safeIndent("flag", receiver, tokenVarName, stringVarName, xVarName, vVarName, okVarName, partVarName)
+	unmarshalVarName := safeIndent("unmarshal", receiver)
+	valueVarName := safeIndent("value", receiver)
+	stateVarName := safeIndent("f", receiver, verbVarName)
+	runeVarName := safeIndent("r", receiver, tokenVarName)
+	nextVarName := safeIndent("next", receiver, tokenVarName, runeVarName)
+	encoderVarName := safeIndent("e", receiver)
+	decoderVarName := safeIndent("d", receiver)
+	startVarName := safeIndent("start", receiver)
+	nameVarName := safeIndent("name", receiver)
+	attrVarName := safeIndent("attr", receiver)
+	writerVarName := safeIndent("w", receiver)
+	nVarName := safeIndent("n", receiver, tokenVarName, stringVarName, xVarName, vVarName, okVarName, partVarName, flagVarName)
+	sizeVarName := safeIndent("size", receiver)
+	cVarName := safeIndent("c", receiver)
+	uVarName := safeIndent("u", receiver)
+	lowerValuesVarName := "_" + tn.Name() + "LowerValues"
+	valuesMapVarName := "_" + tn.Name() + "Values"
+
+	if err := checkComparable(tn); err != nil {
+		return nil, err
+	}
+
+	basic, ok := tn.Type().Underlying().(*types.Basic)
+	if !ok {
+		return nil, fmt.Errorf("underlying type of %s is not a basic type: %v", tn.Name(), tn.Type().Underlying())
+	}
+
+	if basic.Info()&(types.IsString|types.IsInteger|types.IsFloat) == 0 {
+		return nil, fmt.Errorf("unsupported underlying type for %s: %v", tn.Name(), basic)
+	}
+
+	anyOverrides := false
+	// the first constant with each string, name and value is kept to report where collisions come from
+	uniqueStrings := make(map[string]constNameAndString, len(cs))
+	uniqueNames := make(map[string]constNameAndString, len(cs))
+	uniqueValues := make(map[string]constNameAndString, len(cs))
+	uniqueLowerStrings := make(map[string]constNameAndString, len(cs))
+
+	for _, c := range cs {
+		str := c.String
+		name := c.Name
+		repr := c.Const.Val().ExactString()
+
+		if other, ok := uniqueStrings[str]; ok {
+			return nil, fmt.Errorf("duplicate string found: %q (%s)", c.String, collision(fset, other, c))
+		}
+
+		if other, ok := uniqueNames[name]; ok {
+			return nil, fmt.Errorf("duplicate name found: %q (%s)", name, collision(fset, other, c))
+		}
+
+		if other, ok := uniqueNames[str]; ok {
+			return nil, fmt.Errorf("string collides with existing name: %q (%s)", c.String, collision(fset, other, c))
+		}
+
+		if other, ok := uniqueValues[repr]; ok && !opts.AllowAliases {
+			return nil, fmt.Errorf("duplicate value found: %s (%s; use --allow-aliases to allow multiple names for a value)", repr, collision(fset, other, c))
+		}
+
+		if other, ok := uniqueLowerStrings[strings.ToLower(str)]; opts.CaseInsensitive && ok {
+			return nil, fmt.Errorf("strings only differ by case: %q and %q (%s)", other.String, str, collision(fset, other, c))
+		}
+
+		uniqueStrings[str] = c
+		uniqueNames[name] = c
+		uniqueValues[repr] = c
+		uniqueLowerStrings[strings.ToLower(str)] = c
+	}
+
+	// aliases of values can be parsed, but only the first declared constant
+	// of each value is used when formatting values
+	canonical := canonicalConstants(cs)
+	for _, c := range canonical {
+		if kind == constant.String && c.String != constant.StringVal(c.Const.Val()) {
+			anyOverrides = true
+		}
+	}
+
+	for _, b := range opts.Blanks {
+		if repr := b.Val().ExactString(); uniqueValues[repr].Const != nil {
+			return nil, fmt.Errorf("value %s is skipped with a blank identifier, but is also used by a named constant", repr)
+		}
+	}
+
+	if opts.Flags {
+		if err := checkFlagValues(tn, kind, cs); err != nil {
+			return nil, err
+		}
+	}
+
+	if opts.ProtoMaps {
+		if err := checkProtoValues(tn, kind, cs); err != nil {
+			return nil, err
+		}
+	}
+
+	if opts.Msgpack == msgpackInt && kind != constant.Int {
+		return nil, fmt.Errorf("--msgpack=int requires %s to have an integer underlying type", tn.Name())
+	}
+
+	if opts.OutputPkg == "" {
+		for _, name := range []string{
+			tn.Name() + "Values",
+			tn.Name() + "Strings",
+			tn.Name() + "FromOrdinal",
+			tn.Name() + "Count",
+			"_" + tn.Name() + "Count",
+			invalidValueErrorName(tn),
+			tn.Name() + "Entries",
+			entriesVarName(tn),
+		} {
+			// a previous run generated the declaration if it is in a generated file
+			if obj := tn.Pkg().Scope().Lookup(name); obj != nil && findAstFileForToken(obj.Pos(), opts.Generated) == nil {
+				return nil, fmt.Errorf("%s is generated for %s, but it is already declared: %v", name, tn.Name(), obj)
+			}
+		}
+	}
+
+	if opts.Group {
+		// a previous run generated the alias if it is declared in a generated file
+		if obj := tn.Pkg().Scope().Lookup(tn.Name()); obj != nil && findAstFileForToken(obj.Pos(), opts.Generated) == nil {
+			return nil, fmt.Errorf("--group declares %s, but it is already declared: %v", tn.Name(), obj)
+		}
+	}
+
+	if opts.Predicates {
+		if err := checkPredicateNames(tn, cs, opts); err != nil {
+			return nil, err
+		}
+	}
+
+	if opts.FlagsHelpers && opts.OutputPkg == "" {
+		for _, name := range []string{tn.Name() + "None", tn.Name() + "All"} {
+			// a previous run generated the constants if they are declared in a generated file
+			if obj := tn.Pkg().Scope().Lookup(name); obj != nil && findAstFileForToken(obj.Pos(), opts.Generated) == nil {
+				return nil, fmt.Errorf("--flags-helpers generates %s, but it is already declared: %v", name, obj)
+			}
+		}
+	}
+
+	if opts.Iter && opts.OutputPkg == "" {
+		// a previous run generated the function if it is declared in a generated file
+		if obj := tn.Pkg().Scope().Lookup(tn.Name() + "All"); obj != nil && findAstFileForToken(obj.Pos(), opts.Generated) == nil {
+			return nil, fmt.Errorf("--emit-iter generates %sAll, but it is already declared: %v", tn.Name(), obj)
+		}
+	}
+
+	if opts.MinMax && kind != constant.String && opts.OutputPkg == "" {
+		for _, name := range []string{tn.Name() + "Min", tn.Name() + "Max"} {
+			// a previous run generated the constants if they are declared in a generated file
+			if obj := tn.Pkg().Scope().Lookup(name); obj != nil && findAstFileForToken(obj.Pos(), opts.Generated) == nil {
+				return nil, fmt.Errorf("--min-max generates %s, but it is already declared: %v", name, obj)
+			}
+		}
+	}
+
+	// Scan is only generated as a method that reads tokens up to the next space
+	if !opts.Functions && !opts.SQL && opts.Scan != scanValues {
+		if err := warn(checkScanStrings(tn, cs), opts); err != nil {
+			return nil, err
+		}
+	}
+
+	if opts.Flags {
+		if err := warn(checkFlagStrings(tn, cs), opts); err != nil {
+			return nil, err
+		}
+	}
+
+	if opts.TrimSpace {
+		if err := warn(checkTrimSpaceStrings(tn, cs), opts); err != nil {
+			return nil, err
+		}
+	}
+
+	parse := valueParser{
+		partVarName: partVarName,
+		flagVarName: flagVarName,
+		vVarName:    vVarName,
+		okVarName:   okVarName,
+		nVarName:    nVarName,
+		trimSpace:   opts.TrimSpace,
+	}
+	if opts.AcceptNumeric && kind == constant.Int {
+		parse.numeric = basic
+	}
+	switch {
+	case opts.CaseInsensitive:
+		parse.valuesVarName = lowerValuesVarName
+		parse.caseInsensitive = true
+	case opts.Flags || opts.Lookup == lookupMap:
+		// parsers of flag values look up each part of a combined string in a map
+		parse.valuesVarName = valuesMapVarName
+	}
+
+	if opts.OutputPkg != "" && opts.OutputPkg != pkgName {
+		f = jen.NewFile(opts.OutputPkg)
+	} else {
+		f = jen.NewFilePathName(tn.Pkg().Path(), pkgName)
+	}
+	writeHeader(f, reproCmd, opts)
+
+	if opts.Group {
+		f.Line()
+		f.Commentf("%s is the type of the constants %s to %s, which are declared without a named type.", tn.Name(), cs[0].Name, cs[len(cs)-1].Name)
+		f.Type().Id(tn.Name()).Op("=").Id(basic.Name())
+	}
+
+	f.Line()
+	if opts.Flags {
+		generateFlagsStringMethod(f, receiver, tn, basic, canonical, partsVarName, opts)
+	} else {
+		generateStringMethod(f, receiver, kind, tn, basic, canonical, anyOverrides, opts)
+	}
+
+	f.Line()
+	generateBytesMethod(f, receiver, kind, tn, basic, canonical, anyOverrides, opts)
+
+	f.Line()
+	if opts.Flags {
+		generateFlagsDefinedMethod(f, receiver, tn, canonical, opts)
+	} else {
+		generateDefinedMethod(f, receiver, tn, basic, canonical, opts)
+	}
+
+	f.Line()
+	generateValidateMethod(f, receiver, tn, opts)
+
+	if anyDescriptions(canonical) {
+		f.Line()
+		generateDescriptionMethod(f, receiver, tn, canonical, opts)
+	}
+
+	if opts.Predicates {
+		for _, c := range cs {
+			f.Line()
+			generatePredicateMethod(f, receiver, tn, c, opts)
+		}
+	}
+
+	if opts.Flags {
+		f.Line()
+		generateHasMethod(f, receiver, tn, otherVarName, opts)
+
+		f.Line()
+		generateSetMethod(f, receiver, tn, otherVarName, opts)
+
+		f.Line()
+		generateClearMethod(f, receiver, tn, otherVarName, opts)
+
+		if opts.FlagsHelpers {
+			f.Line()
+			generateSplitMethod(f, receiver, tn, canonical, opts)
+
+			f.Line()
+			generateFlagsConstants(f, tn, canonical)
+		}
+	}
+
+	if opts.Functions {
+		// types can't have methods declared outside their package, so only
+		// the functions that don't implement interfaces are generated.
+		f.Line()
+		generateParseFunction(f, tn, basic, cs, stringVarName, parse, opts)
+
+		f.Line()
+		generateNextMethod(f, tn, receiver, canonical, basic, opts)
+
+		f.Line()
+		generatePrevMethod(f, tn, receiver, canonical, basic, opts)
+
+		f.Line()
+		generateValuesFunction(f, tn, canonical, opts)
+
+		f.Line()
+		generateEntriesFunction(f, tn, canonical)
+
+		if opts.Iter {
+			f.Line()
+			generateIterFunction(f, tn)
+		}
+
+		if opts.Random {
+			f.Line()
+			generateRandomFunction(f, tn, opts)
+		}
+
+		if opts.Debug {
+			f.Line()
+			generateDebugStringFunction(f, tn, basic, cs)
+		}
+
+		f.Line()
+		generateCountMethod(f, tn, canonical, opts)
+
+		if opts.MinMax && kind != constant.String {
+			f.Line()
+			generateMinMaxConstants(f, tn, canonical)
+		}
+
+		if opts.ProtoMaps {
+			f.Line()
+			generateProtoMaps(f, tn, cs, canonical)
+		}
+
+		f.Line()
+		generateOrdinalMethod(f, receiver, tn, basic, canonical, opts)
+
+		if opts.Sort {
+			f.Line()
+			generateLessMethod(f, receiver, tn, otherVarName, opts)
+
+			f.Line()
+			generateSortFunction(f, tn, opts)
+		}
+
+		if opts.Set {
+			f.Line()
+			generateSetType(f, tn, opts)
+		}
+
+		if !opts.NoCompileCheck {
+			f.Line()
+			generateCompileCheckFunction(f, xVarName, commandName(reproCmd), cs, kind, basic, opts.Blanks, opts.Excluded, opts.CompileCheckLimit)
+		}
+
+		if opts.StrictCases {
+			f.Line()
+			generateCasesCheckFunction(f, tn, xVarName, commandName(reproCmd), cs, opts.Excluded)
+		}
+
+		if opts.CaseInsensitive {
+			f.Line()
+			generateLowerValuesMap(f, tn, cs, lowerValuesVarName)
+		} else if parse.usesMap() {
+			f.Line()
+			generateValuesMap(f, tn, cs, valuesMapVarName)
+		}
+
+		f.Line()
+		generateValidValuesVar(f, tn, cs)
+
+		f.Line()
+		generateInvalidValueErrorType(f, tn, opts)
+
+		f.Line()
+
+		return f, nil
+	}
+
+	f.Line()
+	if opts.SQL {
+		generateSQLScan(f, receiver, tn, kind, srcVarName, vVarName)
+	} else {
+		generateScanMethod(f, tn, receiver, scanStateVarName, verbVarName, tokenVarName, runeVarName, nextVarName, cs, parse, opts)
+	}
+
+	f.Line()
+	generateNextMethod(f, tn, receiver, canonical, basic, opts)
+
+	f.Line()
+	generatePrevMethod(f, tn, receiver, canonical, basic, opts)
+
+	f.Line()
+	generateValuesFunction(f, tn, canonical, opts)
+
+	f.Line()
+	generateEntriesFunction(f, tn, canonical)
+
+	if opts.Iter {
+		f.Line()
+		generateIterFunction(f, tn)
+	}
+
+	if opts.Random {
+		f.Line()
+		generateRandomFunction(f, tn, opts)
+	}
+
+	if opts.Debug {
+		f.Line()
+		generateDebugStringFunction(f, tn, basic, cs)
+	}
+
+	f.Line()
+	generateCountMethod(f, tn, canonical, opts)
+
+	if opts.MinMax && kind != constant.String {
+		f.Line()
+		generateMinMaxConstants(f, tn, canonical)
+	}
+
+	if opts.ProtoMaps {
+		f.Line()
+		generateProtoMaps(f, tn, cs, canonical)
+	}
+
+	f.Line()
+	generateOrdinalMethod(f, receiver, tn, basic, canonical, opts)
+
+	if opts.Sort {
+		f.Line()
+		generateLessMethod(f, receiver, tn, otherVarName, opts)
+
+		f.Line()
+		generateSortFunction(f, tn, opts)
+	}
+
+	if opts.Set {
+		f.Line()
+		generateSetType(f, tn, opts)
+	}
+
+	if !opts.NoCompileCheck {
+		f.Line()
+		generateCompileCheckFunction(f, xVarName, commandName(reproCmd), cs, kind, basic, opts.Blanks, opts.Excluded, opts.CompileCheckLimit)
+	}
+
+	if opts.StrictCases {
+		f.Line()
+		generateCasesCheckFunction(f, tn, xVarName, commandName(reproCmd), cs, opts.Excluded)
+	}
+
+	f.Line()
+	generateTextMarshal(f, receiver, tn, opts)
+
+	f.Line()
+	generateTextUnmarshal(f, receiver, tn, cs, xVarName, parse, opts)
+
+	// enumer's <Type>String calls Parse<Type>
+	if opts.Lookup == lookupMap || opts.Compat == compatEnumer {
+		f.Line()
+		generateParseFunction(f, tn, basic, cs, stringVarName, parse, opts)
+	}
+
+	if opts.Compat == compatEnumer {
+		f.Line()
+		generateEnumerFunctions(f, receiver, tn, stringVarName)
+	}
+
+	if opts.CaseInsensitive {
+		f.Line()
+		generateLowerValuesMap(f, tn, cs, lowerValuesVarName)
+	} else if parse.usesMap() {
+		f.Line()
+		generateValuesMap(f, tn, cs, valuesMapVarName)
+	}
+
+	f.Line()
+	generateValidValuesVar(f, tn, cs)
+
+	if opts.Scan == scanValues && !opts.SQL {
+		f.Line()
+		generateIsPrefixFunction(f, tn, opts)
+	}
+
+	f.Line()
+	generateInvalidValueErrorType(f, tn, opts)
+
+	if opts.TextAppender {
+		f.Line()
+		generateTextAppend(f, receiver, tn, bVarName, opts)
+	}
+
+	if opts.JSON {
+		f.Line()
+		generateJSONMarshal(f, receiver, tn, opts)
+
+		f.Line()
+		generateJSONUnmarshal(f, receiver, tn, xVarName, stringVarName)
+	}
+
+	if opts.GQLGen {
+		f.Line()
+		generateGQLMarshal(f, receiver, tn, writerVarName)
+
+		f.Line()
+		generateGQLUnmarshal(f, receiver, tn, vVarName, stringVarName, okVarName)
+	}
+
+	if opts.YAML != "" {
+		f.ImportName(yamlPackages[opts.YAML], "yaml")
+
+		f.Line()
+		generateYAMLMarshal(f, receiver, tn)
+
+		f.Line()
+		generateYAMLUnmarshal(f, receiver, tn, opts.YAML, unmarshalVarName, valueVarName, stringVarName)
+	}
+
+	if opts.XML {
+		f.Line()
+		generateXMLMarshal(f, receiver, tn, encoderVarName, startVarName)
+
+		f.Line()
+		generateXMLUnmarshal(f, receiver, tn, decoderVarName, startVarName, stringVarName)
+	}
+
+	if opts.XMLAttr {
+		f.Line()
+		generateXMLAttrMarshal(f, receiver, tn, nameVarName)
+
+		f.Line()
+		generateXMLAttrUnmarshal(f, receiver, tn, attrVarName)
+	}
+
+	if opts.SQL {
+		f.Line()
+		generateSQLValue(f, receiver, tn, kind, basic)
+	}
+
+	if opts.Slog {
+		f.Line()
+		generateLogValue(f, receiver, tn)
+	}
+
+	if opts.Formatter {
+		f.Line()
+		generateFormatMethod(f, receiver, tn, basic, canonical, stateVarName, verbVarName)
+	}
+
+	if opts.Binary {
+		f.Line()
+		generateBinaryMarshal(f, receiver, tn, basic)
+
+		f.Line()
+		generateBinaryUnmarshal(f, receiver, tn, basic, xVarName, vVarName)
+	}
+
+	switch opts.Msgpack {
+	case msgpackString:
+		f.Line()
+		generateMsgpackStringMarshal(f, receiver, tn, stringVarName, nVarName)
+
+		f.Line()
+		generateMsgpackStringUnmarshal(f, receiver, tn, xVarName, nVarName, sizeVarName)
+	case msgpackInt:
+		f.Line()
+		generateMsgpackIntMarshal(f, receiver, tn, basic, nVarName)
+
+		f.Line()
+		generateMsgpackIntUnmarshal(f, receiver, tn, basic, xVarName, nVarName, vVarName, bVarName, cVarName, uVarName)
+	}
+
+	f.Line()
+	generateTypeAssertions(f, tn, basic, opts)
+
+	f.Line()
+
+	return f, nil
+}
+
+// enumMetadata is the JSON description of an enum written by --emit-json.
+type enumMetadata struct {
+	Type       string              `json:"type"`
+	Package    string              `json:"package"`
+	Underlying string              `json:"underlying"`
+	Values     []enumValueMetadata `json:"values"`
+}
+
+// enumValueMetadata is the JSON description of a constant of an enum.
+type enumValueMetadata struct {
+	Name        string `json:"name"`
+	String      string `json:"string"`
+	Value       any    `json:"value"`
+	Description string `json:"description,omitempty"`
+}
+
+// generateEnumJSON generates the JSON description of the enum tn, with the constants cs in declaration order.
+// Numeric values are written as JSON numbers and string values as JSON strings.
+func generateEnumJSON(pkgName string, tn *types.TypeName, cs []constNameAndString) ([]byte, error) {
+	basic, ok := tn.Type().Underlying().(*types.Basic)
+	if !ok {
+		return nil, fmt.Errorf("unsupported underlying type for %s: %v", tn.Name(), tn.Type().Underlying())
+	}
+
+	md := enumMetadata{
+		Type:       tn.Name(),
+		Package:    pkgName,
+		Underlying: basic.Name(),
+		Values:     make([]enumValueMetadata, 0, len(cs)),
+	}
+
+	for _, c := range cs {
+		var value any
+		val := c.Const.Val()
+		switch val.Kind() {
+		case constant.String:
+			value = constant.StringVal(val)
+		case constant.Float:
+			f, _ := constant.Float64Val(val)
+			value = f
+		default:
+			value = json.Number(val.ExactString())
+		}
+
+		md.Values = append(md.Values, enumValueMetadata{
+			Name:        c.Name,
+			String:      c.String,
+			Value:       value,
+			Description: c.Description,
+		})
+	}
+
+	src, err := json.MarshalIndent(md, "", "\t")
+	if err != nil {
+		return nil, err
+	}
+
+	return append(src, '\n'), nil
+}
+
+// writeHeader adds the header comments of a generated file to f.
+// The "Code generated" line is required for tools to recognize the file as generated.
+func writeHeader(f *jen.File, reproCmd string, opts generateOptions) {
+	for _, line := range opts.Header {
+		f.HeaderComment(line)
+	}
+
+	banner := opts.Banner
+	if banner == "" {
+		banner = DefaultBanner
+	}
+	f.HeaderComment(banner)
+	if reproCmd != "" {
+		f.HeaderComment("Command: " + reproCmd)
+	}
+	if opts.BuildConstraint != "" {
+		f.HeaderComment(opts.BuildConstraint)
+	}
+}
+
+// commandName returns the name of the command at the start of reproCmd, which the compile checks tell to re-run.
+func commandName(reproCmd string) string {
+	if fields := strings.Fields(reproCmd); len(fields) > 0 {
+		return fields[0]
+	}
+
+	return "go-enumerator"
+}
+
+// generateCompileCheckFunction generates the _() function that will fail to compile if the constant values have changed.
+// Blank constants can't be referenced, so the values they skip are listed in comments instead.
+// That way, changing which values are skipped shows up when the file is regenerated.
+// String values are checked byte by byte, unless their total length is above limit, in which case
+// generateCompileCompareFunction is used to keep the function small.
+func generateCompileCheckFunction(f *jen.File, xVarName string, command string, cs []constNameAndString, kind constant.Kind, basic *types.Basic, blanks []*types.Const, excluded []constNameAndString, limit int) *jen.Statement {
+	if kind == constant.String && limit > 0 {
+		size := 0
+		for _, c := range append(cs[:len(cs):len(cs)], excluded...) {
+			size += len(constant.StringVal(c.Const.Val()))
+		}
+
+		if size > limit {
+			return generateCompileCompareFunction(f, command, cs, basic, blanks, excluded)
+		}
+	}
+
+	return f.Func().Id("_").Params().BlockFunc(func(g *jen.Group) {
+		g.Var().Id(xVarName).Index(jen.Lit(1)).Struct()
+		g.Comment(`An "invalid array index" compiler error signifies that the constant values have changed.`)
+		g.Commentf(`Re-run the %s command to generate them again.`, command)
+		for i, c := range append(cs[:len(cs):len(cs)], excluded...) {
+			if i == len(cs) {
+				g.Line()
+				g.Comment("Excluded with --exclude")
+			}
+
+			switch kind {
+			case constant.String:
+				v := constant.StringVal(c.Const.Val())
+				g.Line()
+				g.Commentf("Begin %q", v)
+				for j, b := range []byte(v) {
+					g.Id("_").Op("=").Id(xVarName).Index(jen.LitByte(b).Op("-").Add(constRef(c)).Index(jen.Lit(j)))
+				}
+			case constant.Float:
+				// array indexes must be integers, so the difference is converted.
+				// The conversion fails to compile if the difference is not a whole number.
+				g.Id("_").Op("=").Id(xVarName).Index(jen.Int().Parens(constRef(c).Op("-").Op(c.literal(basic))))
+			default:
+				// using jen.Op here is a bit of a hack, but it allows us to
+				// insert the string verbatim without surrounding it with a
+				// type cast (as Lit does)
+				g.Id("_").Op("=").Id(xVarName).Index(widenedConstRef(c, basic).Op("-").Op(c.literal(basic)))
+			}
+		}
+
+		if len(blanks) > 0 {
+			g.Line()
+			for _, b := range blanks {
+				g.Commentf("_ = %s is skipped", constantLiteral(b.Val(), basic))
+			}
+		}
+	})
+}
+
+// generateCompileCompareFunction generates the _() function that will fail to compile if the values of a string enum
+// have changed, with one comparison per constant. A constant map key can only be used once, so a comparison that
+// is false repeats the false key. Blank constants are listed like in generateCompileCheckFunction.
+func generateCompileCompareFunction(f *jen.File, command string, cs []constNameAndString, basic *types.Basic, blanks []*types.Const, excluded []constNameAndString) *jen.Statement {
+	return f.Func().Id("_").Params().BlockFunc(func(g *jen.Group) {
+		g.Comment(`A "duplicate key" compiler error signifies that the constant values have changed.`)
+		g.Commentf(`Re-run the %s command to generate them again.`, command)
+		for i, c := range append(cs[:len(cs):len(cs)], excluded...) {
+			if i == len(cs) {
+				g.Line()
+				g.Comment("Excluded with --exclude")
+			}
+
+			g.Id("_").Op("=").Map(jen.Bool()).Struct().Values(
+				jen.False().Op(":").Values(),
+				constRef(c).Op("==").Lit(constant.StringVal(c.Const.Val())).Op(":").Values(),
+			)
+		}
+
+		if len(blanks) > 0 {
+			g.Line()
+			for _, b := range blanks {
+				g.Commentf("_ = %s is skipped", constantLiteral(b.Val(), basic))
+			}
+		}
+	})
+}
+
+// generateCasesCheckFunction generates a _() function with a switch over every value of the enum, including
+// excluded ones. The compiler doesn't know about constants that were added after the code was generated, but
+// linters that check switches for missing cases, such as github.com/nishanths/exhaustive, report them.
+// Duplicate cases don't compile, so aliases are left out.
+func generateCasesCheckFunction(f *jen.File, tn *types.TypeName, xVarName string, command string, cs []constNameAndString, excluded []constNameAndString) *jen.Statement {
+	return f.Func().Id("_").Params().Block(
+		jen.Var().Id(xVarName).Add(typeRef(tn)),
+		jen.Comment(`A "missing cases in switch" linter error signifies that constants have been added.`),
+		jen.Commentf(`Re-run the %s command to generate them again.`, command),
+		jen.Comment("//exhaustive:enforce"),
+		jen.Switch(jen.Id(xVarName)).Block(
+			jen.CaseFunc(func(g *jen.Group) {
+				for _, c := range canonicalConstants(append(cs[:len(cs):len(cs)], excluded...)) {
+					g.Add(constRef(c))
+				}
+			}),
+		),
+	)
+}
+
+// widenedConstRef returns a reference to c for use in the compile check.
+// Constant expressions must be representable by their type, so constants of integer types narrower
+// than 64 bits are converted to int64. Otherwise, a value that changed near the limits of the type
+// would fail with an overflow error instead of an invalid array index.
+func widenedConstRef(c constNameAndString, basic *types.Basic) *jen.Statement {
+	switch basic.Kind() {
+	case types.Int8, types.Int16, types.Int32, types.Uint8, types.Uint16, types.Uint32:
+		return jen.Int64().Parens(constRef(c))
+	default:
+		return constRef(c)
+	}
+}
+
+func generateTypeAssertions(f *jen.File, eType *types.TypeName, basic *types.Basic, opts generateOptions) {
+	zero := zeroValue(basic)
+
+	// with pointer receivers, some of the interfaces are only implemented by pointers
+	value := func() jen.Code {
+		if opts.ReceiverPointer {
+			return jen.New(jen.Id(eType.Name()))
+		}
+
+		return jen.Id(eType.Name()).Parens(zero.Clone())
+	}
+
+	defs := []jen.Code{
+		jen.Id("_").Qual("fmt", "Stringer").Op("=").Add(value()),
+	}
+
+	if !opts.SQL {
+		defs = append(defs, jen.Id("_").Qual("fmt", "Scanner").Op("=").New(jen.Id(eType.Name())))
+	}
+
+	defs = append(defs,
+		jen.Id("_").Qual("encoding", "TextMarshaler").Op("=").Add(value()),
+		jen.Id("_").Qual("encoding", "TextUnmarshaler").Op("=").New(jen.Id(eType.Name())),
+	)
+
+	if opts.TextAppender {
+		defs = append(defs, jen.Id("_").Qual("encoding", "TextAppender").Op("=").Add(value()))
+	}
+
+	if opts.JSON {
+		defs = append(defs,
+			jen.Id("_").Qual("encoding/json", "Marshaler").Op("=").Add(value()),
+			jen.Id("_").Qual("encoding/json", "Unmarshaler").Op("=").New(jen.Id(eType.Name())),
+		)
+	}
+
+	if opts.GQLGen {
+		// gqlgen isn't imported, so its interfaces are declared inline
+		defs = append(defs,
+			jen.Id("_").Interface(jen.Id("MarshalGQL").Params(jen.Qual("io", "Writer"))).Op("=").Add(value()),
+			jen.Id("_").Interface(jen.Id("UnmarshalGQL").Params(jen.Any()).Error()).Op("=").New(jen.Id(eType.Name())),
+		)
+	}
+
+	if opts.XML {
+		defs = append(defs,
+			jen.Id("_").Qual("encoding/xml", "Marshaler").Op("=").Add(value()),
+			jen.Id("_").Qual("encoding/xml", "Unmarshaler").Op("=").New(jen.Id(eType.Name())),
+		)
+	}
+
+	if opts.XMLAttr {
+		defs = append(defs,
+			jen.Id("_").Qual("encoding/xml", "MarshalerAttr").Op("=").Add(value()),
+			jen.Id("_").Qual("encoding/xml", "UnmarshalerAttr").Op("=").New(jen.Id(eType.Name())),
+		)
+	}
+
+	if opts.SQL {
+		defs = append(defs,
+			jen.Id("_").Qual("database/sql/driver", "Valuer").Op("=").Add(value()),
+			jen.Id("_").Qual("database/sql", "Scanner").Op("=").New(jen.Id(eType.Name())),
+		)
+	}
+
+	if opts.YAML == yamlV3 {
+		// the yaml.v2 interfaces are satisfied as well, but aren't asserted
+		// so that gopkg.in/yaml.v2 doesn't need to be imported
+		defs = append(defs,
+			jen.Id("_").Qual(yamlPackages[yamlV3], "Marshaler").Op("=").Add(value()),
+			jen.Id("_").Qual(yamlPackages[yamlV3], "Unmarshaler").Op("=").New(jen.Id(eType.Name())),
+		)
+	}
+
+	if opts.Formatter {
+		defs = append(defs, jen.Id("_").Qual("fmt", "Formatter").Op("=").Add(value()))
+	}
+
+	if opts.Slog {
+		defs = append(defs, jen.Id("_").Qual("log/slog", "LogValuer").Op("=").Add(value()))
+	}
+
+	if opts.Binary {
+		defs = append(defs,
+			jen.Id("_").Qual("encoding", "BinaryMarshaler").Op("=").Add(value()),
+			jen.Id("_").Qual("encoding", "BinaryUnmarshaler").Op("=").New(jen.Id(eType.Name())),
+		)
+	}
+
+	defs = append(defs,
+		jen.Line(),
+		jen.Commentf("%s must stay comparable, since values are used as map keys and compared with ==", eType.Name()),
+		jen.Id("_").Op("=").Map(jen.Id(eType.Name())).Struct().Values(),
+	)
+
+	f.Var().Defs(defs...)
+}
@@ -0,0 +1,404 @@
+package enumgen
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"strings"
+	"testing"
+
+	"github.com/dave/jennifer/jen"
+)
+
+func TestGenerateNoCompileCheck(t *testing.T) {
+	tn, cs, kind := newTestEnum("Kind", types.Int, []string{"Kind1", "Kind2"}, []any{int64(0), int64(1)})
+
+	got := renderTestEnum(t, tn, cs, kind, generateOptions{})
+	if !strings.Contains(got, "func _()") {
+		t.Errorf("generated code is missing the compile check:\n%s", got)
+	}
+
+	got = renderTestEnum(t, tn, cs, kind, generateOptions{NoCompileCheck: true})
+	if strings.Contains(got, "func _()") {
+		t.Errorf("generated code contains the compile check:\n%s", got)
+	}
+}
+
+func TestGenerateCompileCheckLimit(t *testing.T) {
+	tn, cs, kind := newTestEnum("Kind", types.String, []string{"KindA", "KindB"}, []any{"alpha", "beta"})
+
+	// 9 bytes of values are checked byte by byte up to the limit
+	for _, limit := range []int{0, 9} {
+		got := renderTestEnum(t, tn, cs, kind, generateOptions{CompileCheckLimit: limit})
+		if !containsCode(got, "_ = x[byte(0x61)-KindA[0]]") || containsCode(got, "map[bool]struct{}") {
+			t.Errorf("compile check with limit %d does not index the bytes of the values:\n%s", limit, got)
+		}
+	}
+
+	got := renderTestEnum(t, tn, cs, kind, generateOptions{CompileCheckLimit: 8})
+	for _, want := range []string{
+		`A "duplicate key" compiler error signifies that the constant values have changed.`,
+		"Re-run the go-enumerator command to generate them again.",
+		`_ = map[bool]struct{}{false: {}, KindA == "alpha": {}}`,
+		`_ = map[bool]struct{}{false: {}, KindB == "beta": {}}`,
+	} {
+		if !containsCode(got, want) {
+			t.Errorf("compile check above the limit does not contain %q:\n%s", want, got)
+		}
+	}
+
+	if containsCode(got, "var x [1]struct{}") {
+		t.Errorf("compile check above the limit declares the unused array:\n%s", got)
+	}
+}
+
+func TestGenerateAliases(t *testing.T) {
+	tn, cs, kind := newTestEnum("Kind", types.Int, []string{"Kind1", "Kind2", "Default"}, []any{int64(0), int64(1), int64(0)})
+
+	if _, err := generateEnumCode(token.NewFileSet(), "example", tn, cs, kind, "k", "go-enumerator", generateOptions{}); err == nil || !strings.Contains(err.Error(), "duplicate value found") {
+		t.Errorf("generateEnumCode() = %v, want duplicate value error", err)
+	}
+
+	got := renderTestEnum(t, tn, cs, kind, generateOptions{AllowAliases: true})
+	if want := `case Kind1: return "Kind1" case Kind2: return "Kind2" }`; !containsCode(got, want) {
+		t.Errorf("String() should only use the first declared name:\n%s", got)
+	}
+
+	if want := `case "Default": *k = Default`; !containsCode(got, want) {
+		t.Errorf("Scan() should parse aliases:\n%s", got)
+	}
+}
+
+func TestGenerateCompileCheckNarrowTypes(t *testing.T) {
+	tn, cs, kind := newTestEnum("Level", types.Int8, []string{"LevelMin", "LevelMax"}, []any{int64(-128), int64(127)})
+	basic := tn.Type().Underlying().(*types.Basic)
+
+	f := jen.NewFilePathName("example", "example")
+	generateCompileCheckFunction(f, "x", "go-enumerator", cs, kind, basic, nil, nil, 0)
+
+	var buf bytes.Buffer
+	if err := f.Render(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		values string
+		want   string
+	}{
+		{"-128, 127", ""},
+		{"0, 127", "invalid argument: index"},
+		{"-128, 126", "invalid argument: index"},
+	} {
+		t.Run(tt.values, func(t *testing.T) {
+			fset := token.NewFileSet()
+			src := fmt.Sprintf("package example\n\ntype Level int8\n\nconst LevelMin, LevelMax Level = %s\n", tt.values)
+			files := []*ast.File{}
+			for name, src := range map[string]string{"example.go": src, "level_enum.go": buf.String()} {
+				file, err := parser.ParseFile(fset, name, src, 0)
+				if err != nil {
+					t.Fatal(err)
+				}
+				files = append(files, file)
+			}
+
+			_, err := (&types.Config{}).Check("example", fset, files, nil)
+			switch {
+			case tt.want == "" && err != nil:
+				t.Errorf("Check() = %v, want = nil", err)
+			case tt.want != "" && (err == nil || !strings.Contains(err.Error(), tt.want)):
+				t.Errorf("Check() = %v, want error containing %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestGenerateDeclaredNames(t *testing.T) {
+	for _, decl := range []string{
+		"func KindValues() {}",
+		"var KindStrings []string",
+		"func KindFromOrdinal(int) {}",
+		"func KindCount() int { return 0 }",
+		"const _KindCount = 2",
+		"type InvalidKindError struct{}",
+		"func KindEntries() {}",
+		"var _KindEntries []Kind",
+	} {
+		fset, info, syntax, pkg := checkTestSource(t, `package example
+
+type Kind int
+
+const (
+	KindA Kind = iota
+	KindB
+)
+
+`+decl+"\n")
+		obj := pkg.Scope().Lookup("Kind")
+		cs, kind, err := findConstantsOfType(fset, info, syntax, obj, findOptions{CommentTag: "enum", Banner: DefaultBanner})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		name := strings.Fields(decl)[1]
+		name, _, _ = strings.Cut(name, "(")
+		if _, err := generateEnumCode(fset, "example", obj.(*types.TypeName), cs, kind, "k", "go-enumerator", generateOptions{}); err == nil || !strings.Contains(err.Error(), name+" is generated for Kind, but it is already declared") {
+			t.Errorf("generateEnumCode() with %q = %v, want error for %s", decl, err, name)
+		}
+
+		// a previous run generated the declaration
+		if _, err := generateEnumCode(fset, "example", obj.(*types.TypeName), cs, kind, "k", "go-enumerator", generateOptions{Generated: syntax}); err != nil {
+			t.Errorf("generateEnumCode() with %q in a generated file = %v, want nil", decl, err)
+		}
+
+		// the declarations are generated in another package
+		if _, err := generateEnumCode(fset, "example", obj.(*types.TypeName), cs, kind, "k", "go-enumerator", generateOptions{Functions: true, OutputPkg: "enums"}); err != nil {
+			t.Errorf("generateEnumCode() with %q and --output-pkg = %v, want nil", decl, err)
+		}
+	}
+}
+
+func TestGenerateStrictCases(t *testing.T) {
+	tn, cs, kind := newTestEnum("Kind", types.Int, []string{"Kind1", "Kind2", "KindDefault"}, []any{int64(0), int64(1), int64(0)})
+	excluded := []constNameAndString{{Const: types.NewConst(token.NoPos, tn.Pkg(), "KindUnknown", tn.Type(), constant.MakeInt64(-1)), Name: "KindUnknown"}}
+
+	got := renderTestEnum(t, tn, cs, kind, generateOptions{StrictCases: true, AllowAliases: true, Excluded: excluded})
+	// duplicate cases don't compile, so the alias is left out
+	for _, want := range []string{"//exhaustive:enforce", "case Kind1, Kind2, KindUnknown:"} {
+		if !containsCode(got, want) {
+			t.Errorf("generated code does not contain %q:\n%s", want, got)
+		}
+	}
+
+	if got := renderTestEnum(t, tn, cs, kind, generateOptions{AllowAliases: true}); containsCode(got, "exhaustive") {
+		t.Errorf("generated code contains the cases check without --strict-cases:\n%s", got)
+	}
+}
+
+func TestAddBuildConstraint(t *testing.T) {
+	tests := []struct {
+		line string
+		want string
+	}{
+		{"", "//go:build go1.24"},
+		{"//go:build unix", "//go:build unix && go1.24"},
+		{"//go:build linux || darwin", "//go:build (linux || darwin) && go1.24"},
+	}
+
+	for _, tt := range tests {
+		got, err := addBuildConstraint(tt.line, "go1.24")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got != tt.want {
+			t.Errorf("addBuildConstraint(%q) = %q, want = %q", tt.line, got, tt.want)
+		}
+	}
+}
+
+func TestGenerateStrict(t *testing.T) {
+	tn, cs, kind := newTestEnum("Flag", types.Int, []string{"FlagA", "FlagB"}, []any{int64(1), int64(2)})
+	cs[1].String = "B|C"
+
+	if _, err := generateEnumCode(token.NewFileSet(), "example", tn, cs, kind, "f", "go-enumerator", generateOptions{Flags: true, Strict: true}); err == nil || !strings.Contains(err.Error(), `"B|C"`) {
+		t.Errorf("generateEnumCode() = %v, want error for %q", err, "B|C")
+	}
+}
+
+func TestGenerateEnumJSON(t *testing.T) {
+	tn, cs, _ := newTestEnum("Kind", types.Int, []string{"Kind1", "Kind2"}, []any{int64(0), int64(10)})
+	cs[1].String = "Other"
+	cs[1].Description = "The other kind"
+
+	got, err := generateEnumJSON("example", tn, cs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{
+	"type": "Kind",
+	"package": "example",
+	"underlying": "int",
+	"values": [
+		{
+			"name": "Kind1",
+			"string": "Kind1",
+			"value": 0
+		},
+		{
+			"name": "Kind2",
+			"string": "Other",
+			"value": 10,
+			"description": "The other kind"
+		}
+	]
+}
+`
+	if string(got) != want {
+		t.Errorf("generateEnumJSON() = %s, want = %s", got, want)
+	}
+
+	tn, cs, _ = newTestEnum("StrKind", types.String, []string{"A"}, []any{"a"})
+	got, err = generateEnumJSON("example", tn, cs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(string(got), `"value": "a"`) {
+		t.Errorf("generateEnumJSON() = %s, want string value", got)
+	}
+}
+
+// TestGenerateReceiverNames checks that the generated code compiles when the receiver has the
+// name of an identifier that is declared in the generated methods or used by them.
+func TestGenerateReceiverNames(t *testing.T) {
+	src := `package example
+
+type Kind int
+
+const (
+	Kind1 Kind = 1 << iota
+	Kind2
+	Kind3
+)
+`
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "example.go", src, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	conf := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+	pkg, err := conf.Check("example", fset, []*ast.File{file}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tn := pkg.Scope().Lookup("Kind").(*types.TypeName)
+	var cs []constNameAndString
+	for _, name := range []string{"Kind1", "Kind2", "Kind3"} {
+		c := pkg.Scope().Lookup(name).(*types.Const)
+		cs = append(cs, constNameAndString{Const: c, Name: name, String: name})
+	}
+
+	allOpts := map[string]generateOptions{
+		"default": {
+			JSON: true, GQLGen: true, XML: true, XMLAttr: true, Slog: true, Binary: true, Formatter: true,
+			TextAppender: true, Set: true, Random: true, Sort: true, StrictCases: true, Values: valuesArray, AcceptNumeric: true,
+		},
+		"flags":            {Flags: true, FlagsHelpers: true, AcceptNumeric: true},
+		"map":              {Lookup: lookupMap, AcceptNumeric: true},
+		"case-insensitive": {CaseInsensitive: true, AcceptNumeric: true},
+		"pointer":          {ReceiverPointer: true, AcceptNumeric: true},
+		"pointer sql":      {ReceiverPointer: true, SQL: true},
+		"functions":        {Functions: true, AcceptNumeric: true},
+		"scan values":      {Scan: scanValues},
+		"sql":              {SQL: true},
+		"yaml v2":          {YAML: yamlV2},
+		"msgpack":          {Msgpack: msgpackString},
+		"msgpack int":      {Msgpack: msgpackInt},
+	}
+
+	receivers := []string{"x", "str", "token", "verb", "scanState", "err", "r", "next", "v", "ok", "part", "flag", "flags", "b", "w", "fmt", "strings", "string", "len", "n", "c", "u", "size", "errors", "Kind", "Kind1"}
+	for name, opts := range allOpts {
+		for _, receiver := range receivers {
+			f, err := generateEnumCode(token.NewFileSet(), "example", tn, cs, constant.Int, safeIndent(receiver), "go-enumerator", opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var buf bytes.Buffer
+			if err := f.Render(&buf); err != nil {
+				t.Fatal(err)
+			}
+
+			// gopkg.in/yaml.v2 can't be loaded here, and the generated methods don't refer to it
+			got := strings.ReplaceAll(buf.String(), `yaml "gopkg.in/yaml.v2"`, "")
+			generated, err := parser.ParseFile(fset, "kind_enum.go", got, 0)
+			if err != nil {
+				t.Fatalf("%s, receiver %s: %v", name, receiver, err)
+			}
+
+			if _, err := conf.Check("example", fset, []*ast.File{file, generated}, nil); err != nil {
+				t.Errorf("%s, receiver %s: Check() = %v\n%s", name, receiver, err, got)
+			}
+		}
+	}
+}
+
+func TestGenerateComputedValues(t *testing.T) {
+	src := `package example
+
+type Kind int
+
+const (
+	KindBase Kind = iota + 100
+	KindNext
+	KindDerived = KindBase + 10
+	KindDouble  = KindBase * 2
+)
+
+const (
+	KindShift1 Kind = 1 << (iota + 9)
+	KindShift2
+	KindShift3
+)
+`
+	fset, info, syntax, pkg := checkTestSource(t, src)
+	obj := pkg.Scope().Lookup("Kind")
+	cs, kind, err := findConstantsOfType(fset, info, syntax, obj, findOptions{CommentTag: "enum", Banner: DefaultBanner})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]string{
+		"KindBase":    "100",
+		"KindNext":    "101",
+		"KindDerived": "110",
+		"KindDouble":  "200",
+		"KindShift1":  "512",
+		"KindShift2":  "1024",
+		"KindShift3":  "2048",
+	}
+	for _, c := range cs {
+		if got := c.Const.Val().ExactString(); got != want[c.Name] {
+			t.Errorf("%s = %s, want = %s", c.Name, got, want[c.Name])
+		}
+
+		if c.Literal != "" {
+			t.Errorf("%s.Literal = %q, want = %q", c.Name, c.Literal, "")
+		}
+	}
+
+	got := renderTestEnum(t, obj.(*types.TypeName), cs, kind, generateOptions{})
+	for _, want := range []string{
+		"case 100, 101, 110, 200, 512, 1024, 2048:",
+		"_ = x[KindDerived-110]",
+		"_ = x[KindShift3-2048]",
+		"case KindDouble:\n\t\treturn KindShift1",
+	} {
+		if !containsCode(got, want) {
+			t.Errorf("generated code does not contain %q:\n%s", want, got)
+		}
+	}
+
+	// the generated code must compile alongside the constants
+	var files []*ast.File
+	for name, src := range map[string]string{"example.go": src, "kind_enum.go": got} {
+		file, err := parser.ParseFile(fset, name, src, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		files = append(files, file)
+	}
+
+	conf := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+	if _, err := conf.Check("example", fset, files, nil); err != nil {
+		t.Errorf("Check() = %v", err)
+	}
+}
@@ -0,0 +1,548 @@
+package enumgen
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/build/constraint"
+	"go/token"
+	"go/types"
+	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// loadPackage loads the package of the files inputFileNames, or the package in directory dir if it is set.
+// An empty pkgName matches any package. overlay may provide the contents of files that do not exist on disk.
+func loadPackage(pkgName string, inputFileNames []string, dir, tags string, overlay map[string][]byte) (*packages.Package, error) {
+	var buildFlags []string
+	if tags != "" {
+		buildFlags = append(buildFlags, "-tags="+tags)
+	}
+
+	var patterns []string
+	for _, inputFileName := range inputFileNames {
+		patterns = append(patterns, fmt.Sprintf("file=%s", inputFileName))
+	}
+	if dir != "" {
+		patterns = []string{"."}
+	}
+
+	pkgs, err := packages.Load(&packages.Config{
+		Mode: packages.NeedName |
+			packages.NeedTypes |
+			packages.NeedTypesInfo |
+			packages.NeedDeps |
+			packages.NeedSyntax |
+			packages.NeedImports,
+		BuildFlags: buildFlags,
+		Dir:        dir,
+		Overlay:    overlay},
+		patterns...)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret *packages.Package
+	for _, pkg := range pkgs {
+		if pkgName != "" && pkg.Name != pkgName {
+			continue
+		}
+
+		if ret != nil {
+			return nil, fmt.Errorf("multiple packages found with name %s", pkg.Name)
+		}
+
+		ret = pkg
+	}
+
+	if ret == nil && pkgName == "" {
+		return nil, fmt.Errorf("no packages found in %s", dir)
+	}
+
+	if ret == nil {
+		return nil, fmt.Errorf("no packages found with name %s", pkgName)
+	}
+
+	return ret, nil
+}
+
+// findTypeDecl find the relevant *types.TypeName from fset & info.
+// If name is passed, a type with that name is searched for, using line to choose between types with the same name.
+// If strict is set, it is an error if line can't be used to choose between them.
+// Otherwise, the first type after line in the input files is returned.
+// If the next declaration after line is not a *types.TypeName,
+// an error is returned. Aliases are resolved using resolveAlias.
+func findTypeDecl(fset *token.FileSet, info *types.Info, name string, inputFileNames []string, overlay map[string][]byte, line int, strict bool) (*types.TypeName, error) {
+	var tn *types.TypeName
+	var err error
+	if name != "" {
+		tn, err = findTypeDeclByName(fset, info, name, inputFileNames, overlay, line, strict)
+	} else {
+		tn, err = findTypeDeclByPosition(fset, info, inputFileNames, overlay, line)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return resolveAlias(tn)
+}
+
+// resolveAlias returns the type name of the named type that tn is an alias of, such as size for
+// type Size = size. Methods can only be declared on named types, and constants declared with
+// the alias have the named type. tn is returned if it is not an alias.
+func resolveAlias(tn *types.TypeName) (*types.TypeName, error) {
+	if !tn.IsAlias() {
+		return tn, nil
+	}
+
+	t := types.Unalias(tn.Type())
+	named, ok := t.(*types.Named)
+	if !ok {
+		return nil, fmt.Errorf("type %s is an alias of %s, which is not a named type", tn.Name(), t)
+	}
+
+	if named.TypeArgs().Len() > 0 {
+		return nil, fmt.Errorf("type %s is an alias of %s, which is an instantiated generic type", tn.Name(), t)
+	}
+
+	if named.Obj().Pkg() != tn.Pkg() {
+		return nil, fmt.Errorf("type %s is an alias of %s, which is declared in another package", tn.Name(), t)
+	}
+
+	return named.Obj(), nil
+}
+
+// findConstantGroup finds the const block for --group, which must be the next declaration after line in inputFileName.
+// Its constants must be untyped or of the same basic type. That type, or the default type of untyped constants, is returned.
+func findConstantGroup(fset *token.FileSet, info *types.Info, syntax []*ast.File, inputFileName string, overlay map[string][]byte, line int) (*ast.GenDecl, *types.Basic, error) {
+	for _, f := range syntax {
+		same, err := isInputFile(fset.Position(f.Package).Filename, inputFileName, overlay)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if !same {
+			continue
+		}
+
+		for _, d := range f.Decls {
+			if fset.Position(d.Pos()).Line <= line {
+				continue
+			}
+
+			decl, ok := d.(*ast.GenDecl)
+			if !ok || decl.Tok != token.CONST {
+				return nil, nil, fmt.Errorf("%s: --group requires a const block after line %d", fset.Position(d.Pos()), line)
+			}
+
+			basic, err := constantGroupType(info, decl)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			return decl, basic, nil
+		}
+
+		return nil, nil, fmt.Errorf("--group requires a const block after line %d of %s", line, inputFileName)
+	}
+
+	return nil, nil, fmt.Errorf("input file %s not found in the package", inputFileName)
+}
+
+// constantGroupType returns the basic type shared by the constants declared in decl.
+func constantGroupType(info *types.Info, decl *ast.GenDecl) (*types.Basic, error) {
+	var basic *types.Basic
+	var first *types.Const
+	for _, spec := range decl.Specs {
+		for _, ident := range spec.(*ast.ValueSpec).Names {
+			c, ok := info.Defs[ident].(*types.Const)
+			if !ok || c.Name() == "_" {
+				continue
+			}
+
+			if named, ok := types.Unalias(c.Type()).(*types.Named); ok {
+				return nil, fmt.Errorf("constant %s has the named type %s, so --type=%s can be used instead of --group", c.Name(), named.Obj().Name(), named.Obj().Name())
+			}
+
+			b, ok := types.Default(c.Type()).(*types.Basic)
+			if !ok {
+				return nil, fmt.Errorf("constant %s does not have a basic type: %v", c.Name(), c.Type())
+			}
+
+			if basic != nil && !types.Identical(b, basic) {
+				return nil, fmt.Errorf("constants %s and %s of the group have different types: %v and %v", first.Name(), c.Name(), basic, b)
+			}
+
+			if basic == nil {
+				basic, first = b, c
+			}
+		}
+	}
+
+	if basic == nil {
+		return nil, errors.New("--group requires a const block with named constants")
+	}
+
+	return basic, nil
+}
+
+// findTypeDeclsInFiles finds all *types.TypeName declared in inputFileNames.
+// The results are ordered by the order of the files and where they are declared in them.
+func findTypeDeclsInFiles(fset *token.FileSet, info *types.Info, inputFileNames []string, overlay map[string][]byte) ([]*types.TypeName, error) {
+	var ret []*types.TypeName
+	files := make(map[*types.TypeName]int)
+	for _, object := range info.Defs {
+		c, ok := object.(*types.TypeName)
+		if !ok {
+			continue
+		}
+
+		// skip types declared inside functions
+		if c.Parent() != c.Pkg().Scope() {
+			continue
+		}
+
+		// the code is generated for the aliased type where it is declared
+		if c.IsAlias() {
+			continue
+		}
+
+		file, err := inputFileIndex(fset.Position(c.Pos()).Filename, inputFileNames, overlay)
+		if err != nil {
+			return nil, err
+		}
+
+		if file < 0 {
+			continue
+		}
+
+		ret = append(ret, c)
+		files[c] = file
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		if files[ret[i]] != files[ret[j]] {
+			return files[ret[i]] < files[ret[j]]
+		}
+
+		return ret[i].Pos() < ret[j].Pos()
+	})
+
+	return ret, nil
+}
+
+// noConstantsError returns the error for a type without constants. The types that do have constants
+// declared in inputFileNames are suggested, since the wrong type may have been chosen with --type.
+// If there are no input files, as with --dir, the constants of the whole package are considered.
+func noConstantsError(fset *token.FileSet, info *types.Info, tn *types.TypeName, inputFileNames []string, overlay map[string][]byte) error {
+	var consts []*types.Const
+	for _, object := range info.Defs {
+		c, ok := object.(*types.Const)
+		if !ok || c.Parent() != c.Pkg().Scope() {
+			continue
+		}
+
+		if len(inputFileNames) > 0 {
+			file, err := inputFileIndex(fset.Position(c.Pos()).Filename, inputFileNames, overlay)
+			if err != nil {
+				return err
+			}
+
+			if file < 0 {
+				continue
+			}
+		}
+
+		consts = append(consts, c)
+	}
+
+	sort.Slice(consts, func(i, j int) bool {
+		return consts[i].Pos() < consts[j].Pos()
+	})
+
+	var names []string
+	for _, c := range consts {
+		// enums can only be generated for types of the package
+		named, ok := types.Unalias(c.Type()).(*types.Named)
+		if !ok || named.Obj().Pkg() != c.Pkg() || named.Obj() == tn {
+			continue
+		}
+
+		if !slices.Contains(names, named.Obj().Name()) {
+			names = append(names, named.Obj().Name())
+		}
+	}
+
+	if len(names) == 0 {
+		return fmt.Errorf("no constants of type %q found", tn.Name())
+	}
+
+	var bases []string
+	for _, inputFileName := range inputFileNames {
+		bases = append(bases, filepath.Base(inputFileName))
+	}
+
+	where := strings.Join(bases, ", ")
+	if len(inputFileNames) == 0 {
+		where = "package " + tn.Pkg().Name()
+	}
+
+	return fmt.Errorf("no constants of type %q found; types with constants in %s: %s", tn.Name(), where, strings.Join(names, ", "))
+}
+
+// findTypeDeclByPosition finds the *types.TypeName of the first declaration in the first input file after line,
+// which is where go:generate directives are written. The directive can either be above the type,
+// or above a block of constants of the type, which is then declared before or after the block.
+// If nothing is declared after line, the first declaration of the next input file that declares anything is used.
+func findTypeDeclByPosition(fset *token.FileSet, info *types.Info, inputFileNames []string, overlay map[string][]byte, line int) (*types.TypeName, error) {
+	var closest types.Object
+	closestFile := len(inputFileNames)
+	for _, object := range info.Defs {
+		if object == nil {
+			continue
+		}
+
+		p := fset.Position(object.Pos())
+		file, err := inputFileIndex(p.Filename, inputFileNames, overlay)
+		if err != nil {
+			return nil, err
+		}
+
+		// line refers to the file with the directive
+		if file < 0 || file == 0 && p.Line < line {
+			continue
+		}
+
+		// positions are compared instead of lines, so that the first of several objects on one line is used
+		if file < closestFile || file == closestFile && object.Pos() < closest.Pos() {
+			closest = object
+			closestFile = file
+		}
+	}
+
+	switch o := closest.(type) {
+	case nil:
+		return nil, fmt.Errorf("failed to determine type: nothing is declared after line %d, so the type must be specified with --type", line)
+	case *types.TypeName:
+		return o, nil
+	case *types.Const:
+		named, ok := types.Unalias(o.Type()).(*types.Named)
+		if !ok || named.Obj().Pkg() != o.Pkg() {
+			return nil, fmt.Errorf("failed to determine type: the closest declaration is constant %s of type %s, which is not a named type of this package, so the type must be specified with --type", o.Name(), o.Type())
+		}
+
+		return named.Obj(), nil
+	default:
+		return nil, fmt.Errorf("failed to determine type: the closest declaration is not a named type or a constant: %v, so the type must be specified with --type", closest)
+	}
+}
+
+// findTypeDeclByName finds the the *types.TypeName in info named name.
+// Types declared inside functions can share a name, so if there are several,
+// the one in the first input file that is declared nearest line is returned. Declarations
+// after line win ties, since go:generate directives are written above the type.
+// If line is not set or none of them are in the first input file, the one declared at package scope is returned,
+// unless strict is set, in which case an error is returned.
+func findTypeDeclByName(fset *token.FileSet, info *types.Info, name string, inputFileNames []string, overlay map[string][]byte, line int, strict bool) (*types.TypeName, error) {
+	var matches []*types.TypeName
+	for _, object := range info.Defs {
+		if object == nil {
+			continue
+		}
+
+		c, ok := object.(*types.TypeName)
+		if !ok {
+			continue
+		}
+
+		if c.Name() != name {
+			continue
+		}
+
+		matches = append(matches, c)
+	}
+
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("type %q not found", name)
+	}
+
+	if len(matches) == 1 {
+		return matches[0], nil
+	}
+
+	// info.Defs is a map, so sort to make the result deterministic
+	sort.Slice(matches, func(i, j int) bool {
+		return matches[i].Pos() < matches[j].Pos()
+	})
+
+	if line > 0 {
+		var ret *types.TypeName
+		closest := math.MaxInt32
+		for _, c := range matches {
+			// line refers to the file with the directive
+			p := fset.Position(c.Pos())
+			file, err := inputFileIndex(p.Filename, inputFileNames, overlay)
+			if err != nil {
+				return nil, err
+			}
+
+			if file != 0 {
+				continue
+			}
+
+			distance := p.Line - line
+			if distance < 0 {
+				distance = -distance
+			}
+
+			if distance < closest || distance == closest && p.Line > line {
+				ret = c
+				closest = distance
+			}
+		}
+
+		if ret != nil {
+			return ret, nil
+		}
+	}
+
+	if strict {
+		return nil, fmt.Errorf("%d types named %q found, but none of them are declared in the input file near --line (--strict)", len(matches), name)
+	}
+
+	for _, c := range matches {
+		if c.Parent() == c.Pkg().Scope() {
+			return c, nil
+		}
+	}
+
+	return matches[0], nil
+}
+
+// findBuildConstraint returns the //go:build line of file, or an empty string if it has none.
+// Constraints implied by the file name, such as _linux.go, are not considered.
+func findBuildConstraint(file *ast.File) string {
+	if file == nil {
+		return ""
+	}
+
+	for _, cg := range file.Comments {
+		if cg.Pos() > file.Package {
+			break
+		}
+
+		for _, c := range cg.List {
+			if constraint.IsGoBuild(c.Text) {
+				return strings.TrimSpace(c.Text)
+			}
+		}
+	}
+
+	return ""
+}
+
+// isGeneratedFile reports whether file was generated by go-enumerator, which is recognized by
+// the banner line before the package clause. Declarations in such files, like the <Type>Min
+// and <Type>Max constants, are replaced when the enum is regenerated, so they are not part of it.
+// DefaultBanner is recognized as well, so that files generated before --banner was set are replaced too.
+func isGeneratedFile(file *ast.File, banner string) bool {
+	if file == nil {
+		return false
+	}
+
+	for _, cg := range file.Comments {
+		if cg.Pos() > file.Package {
+			break
+		}
+
+		for _, c := range cg.List {
+			if text := strings.TrimSpace(strings.TrimPrefix(c.Text, "//")); text == banner || text == DefaultBanner {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// generatedFiles returns the files of syntax that were generated by go-enumerator with banner.
+func generatedFiles(syntax []*ast.File, banner string) []*ast.File {
+	var ret []*ast.File
+	for _, file := range syntax {
+		if isGeneratedFile(file, banner) {
+			ret = append(ret, file)
+		}
+	}
+
+	return ret
+}
+
+// fileNames returns the base names of the files in syntax, such as for reporting which files were searched.
+func fileNames(fset *token.FileSet, syntax []*ast.File) []string {
+	var ret []string
+	for _, file := range syntax {
+		ret = append(ret, filepath.Base(fset.File(file.Pos()).Name()))
+	}
+
+	return ret
+}
+
+func findAstFileForToken(pos token.Pos, syntax []*ast.File) *ast.File {
+	for _, file := range syntax {
+		if pos < file.FileStart {
+			continue
+		}
+		if pos > file.FileEnd {
+			continue
+		}
+		return file
+	}
+	return nil
+}
+
+// isInputFile determines if filename is the input file inputFileName.
+// Files in overlay, such as source read from standard input, only exist in memory, so they are matched by name.
+func isInputFile(filename, inputFileName string, overlay map[string][]byte) (bool, error) {
+	if _, ok := overlay[inputFileName]; ok {
+		return filename == inputFileName, nil
+	}
+
+	return sameFile(filename, inputFileName)
+}
+
+// inputFileIndex returns the index of filename in inputFileNames, or -1 if it is not one of the input files.
+func inputFileIndex(filename string, inputFileNames []string, overlay map[string][]byte) (int, error) {
+	for i, inputFileName := range inputFileNames {
+		same, err := isInputFile(filename, inputFileName, overlay)
+		if err != nil {
+			return -1, err
+		}
+
+		if same {
+			return i, nil
+		}
+	}
+
+	return -1, nil
+}
+
+// sameFile determines if a and b point to the same file
+func sameFile(a, b string) (bool, error) {
+	as, err := os.Stat(a)
+	if err != nil {
+		return false, err
+	}
+
+	bs, err := os.Stat(b)
+	if err != nil {
+		return false, err
+	}
+
+	return os.SameFile(as, bs), nil
+}
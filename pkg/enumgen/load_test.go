@@ -0,0 +1,507 @@
+package enumgen
+
+import (
+	"errors"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSameFile(t *testing.T) {
+	dir := t.TempDir()
+	a := filepath.Join(dir, "a.go")
+	b := filepath.Join(dir, "b.go")
+	for _, name := range []string{a, b} {
+		if err := os.WriteFile(name, []byte("package example\n"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if same, err := sameFile(a, filepath.Join(dir, ".", "a.go")); err != nil || !same {
+		t.Errorf("sameFile() = %v, %v, want = true, nil", same, err)
+	}
+
+	if same, err := sameFile(a, b); err != nil || same {
+		t.Errorf("sameFile() = %v, %v, want = false, nil", same, err)
+	}
+
+	if _, err := sameFile(a, filepath.Join(dir, "missing.go")); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("sameFile() = %v, want = %v", err, os.ErrNotExist)
+	}
+}
+
+func TestFindTypeDeclMissingFile(t *testing.T) {
+	fset, info, _, _ := checkTestSource(t, `package example
+
+type Kind int
+`)
+
+	// example.go only exists in memory, so it can't be compared against the input file
+	_, err := findTypeDecl(fset, info, "", []string{filepath.Join(t.TempDir(), "missing.go")}, nil, 1, false)
+	if !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("findTypeDecl() = %v, want = %v", err, os.ErrNotExist)
+	}
+}
+
+func TestFindTypeDeclByPosition(t *testing.T) {
+	src := `package example
+
+//go:generate go-enumerator
+type Kind int
+
+const (
+	Kind1 Kind = iota
+	Kind2
+)
+
+//go:generate go-enumerator
+const (
+	Color1 Color = iota
+	Color2
+)
+
+type Color int
+
+type Shape int
+
+//go:generate go-enumerator
+const (
+	Shape1 Shape = iota
+	Shape2
+)
+
+//go:generate go-enumerator
+const MaxCount = 10
+`
+
+	// the input file must exist to be compared with the file declaring each type
+	name := filepath.Join(t.TempDir(), "example.go")
+	if err := os.WriteFile(name, []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, name, src, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	info := &types.Info{Defs: make(map[*ast.Ident]types.Object)}
+	if _, err := new(types.Config).Check("example", fset, []*ast.File{f}, info); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		line    int
+		want    string
+		wantErr string
+	}{
+		{line: 3, want: "Kind"},   // directive above the type
+		{line: 11, want: "Color"}, // directive above constants of a type declared afterward
+		{line: 21, want: "Shape"}, // directive above constants of a type declared before
+		{line: 27, wantErr: "--type"},
+		{line: 30, wantErr: "--type"},
+	}
+
+	for _, tt := range tests {
+		tn, err := findTypeDecl(fset, info, "", []string{name}, nil, tt.line, false)
+		if tt.wantErr != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("findTypeDecl(line %d) = %v, %v, want error containing %q", tt.line, tn, err, tt.wantErr)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("findTypeDecl(line %d) = %v", tt.line, err)
+		} else if tn.Name() != tt.want {
+			t.Errorf("findTypeDecl(line %d) = %s, want = %s", tt.line, tn.Name(), tt.want)
+		}
+	}
+}
+
+func TestFindTypeDeclMultipleInputFiles(t *testing.T) {
+	srcs := []string{`package example
+
+//go:generate go-enumerator --input=a.go,b.go
+const (
+	Kind1 Kind = iota
+	Kind2
+)
+
+//go:generate go-enumerator --input=a.go,b.go
+`, `package example
+
+type Color int
+
+type Kind int
+
+const Kind3 Kind = 2
+`}
+
+	// the input files must exist to be compared with the file declaring each type
+	dir := t.TempDir()
+	fset := token.NewFileSet()
+	var names []string
+	var files []*ast.File
+	for i, src := range srcs {
+		name := filepath.Join(dir, string(rune('a'+i))+".go")
+		if err := os.WriteFile(name, []byte(src), 0o644); err != nil {
+			t.Fatal(err)
+		}
+
+		f, err := parser.ParseFile(fset, name, src, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		names = append(names, name)
+		files = append(files, f)
+	}
+
+	info := &types.Info{Defs: make(map[*ast.Ident]types.Object)}
+	if _, err := new(types.Config).Check("example", fset, files, info); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		line int
+		want string
+	}{
+		{line: 3, want: "Kind"},  // the constants after the directive in the first file
+		{line: 9, want: "Color"}, // nothing after the directive, so the first declaration of the next file
+	}
+
+	for _, tt := range tests {
+		tn, err := findTypeDecl(fset, info, "", names, nil, tt.line, false)
+		if err != nil {
+			t.Errorf("findTypeDecl(line %d) = %v", tt.line, err)
+		} else if tn.Name() != tt.want {
+			t.Errorf("findTypeDecl(line %d) = %s, want = %s", tt.line, tn.Name(), tt.want)
+		}
+	}
+
+	// the types are ordered by the order of the input files
+	tns, err := findTypeDeclsInFiles(fset, info, []string{names[1], names[0]}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(tns) != 2 || tns[0].Name() != "Color" || tns[1].Name() != "Kind" {
+		t.Errorf("findTypeDeclsInFiles() = %v, want = [Color Kind]", tns)
+	}
+
+	if tns, err := findTypeDeclsInFiles(fset, info, names[:1], nil); err != nil || len(tns) != 0 {
+		t.Errorf("findTypeDeclsInFiles(a.go) = %v, %v, want = [], nil", tns, err)
+	}
+}
+
+func TestFindTypeDeclByNameSameName(t *testing.T) {
+	src := `package example
+
+type Kind int
+
+func f() {
+	type Kind string
+
+	const (
+		Kind1 Kind = "1"
+	)
+}
+
+func g() {
+	type Kind float64
+}
+`
+
+	// the input file must exist to be compared with the file declaring each type
+	name := filepath.Join(t.TempDir(), "example.go")
+	if err := os.WriteFile(name, []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, name, src, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	info := &types.Info{Defs: make(map[*ast.Ident]types.Object)}
+	if _, err := new(types.Config).Check("example", fset, []*ast.File{f}, info); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		line int
+		want string
+	}{
+		{0, "int"},
+		{2, "int"},
+		{5, "string"},
+		{6, "string"},
+		{12, "float64"},
+		{100, "float64"},
+	}
+
+	for _, tt := range tests {
+		tn, err := findTypeDecl(fset, info, "Kind", []string{name}, nil, tt.line, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got := tn.Type().Underlying().String(); got != tt.want {
+			t.Errorf("findTypeDecl(line %d) has underlying type %s, want = %s", tt.line, got, tt.want)
+		}
+	}
+
+	// without a line, the type declared at package scope is only chosen if --strict is not set
+	if _, err := findTypeDecl(fset, info, "Kind", []string{name}, nil, 0, true); err == nil {
+		t.Errorf("findTypeDecl() with --strict expected error")
+	}
+
+	if _, err := findTypeDecl(fset, info, "Kind", []string{name}, nil, 5, true); err != nil {
+		t.Errorf("findTypeDecl() with --strict = %v, want nil", err)
+	}
+}
+
+func TestFindTypeDeclAlias(t *testing.T) {
+	src := `package example
+
+type Kind = kind
+
+type kind int
+
+const (
+	Kind1 Kind = iota
+	Kind2
+)
+
+type Number = int
+`
+
+	// the input file must exist to be compared with the file declaring each type
+	name := filepath.Join(t.TempDir(), "example.go")
+	if err := os.WriteFile(name, []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, name, src, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	info := &types.Info{Defs: make(map[*ast.Ident]types.Object)}
+	if _, err := new(types.Config).Check("example", fset, []*ast.File{f}, info); err != nil {
+		t.Fatal(err)
+	}
+
+	tn, err := findTypeDecl(fset, info, "Kind", []string{name}, nil, 0, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if tn.Name() != "kind" {
+		t.Errorf("findTypeDecl() = %s, want = kind", tn.Name())
+	}
+
+	cs, _, err := findConstantsOfType(fset, info, []*ast.File{f}, tn, findOptions{CommentTag: "enum", Banner: DefaultBanner})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(cs) != 2 || cs[0].Name != "Kind1" || cs[1].Name != "Kind2" {
+		t.Errorf("findConstantsOfType() = %v, want = [Kind1 Kind2]", cs)
+	}
+
+	// the alias is skipped, since its code is generated for kind
+	tns, err := findTypeDeclsInFiles(fset, info, []string{name}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(tns) != 1 || tns[0].Name() != "kind" {
+		t.Errorf("findTypeDeclsInFiles() = %v, want = [kind]", tns)
+	}
+
+	if _, err := findTypeDecl(fset, info, "Number", []string{name}, nil, 0, false); err == nil || !strings.Contains(err.Error(), "not a named type") {
+		t.Errorf("findTypeDecl() of an alias of int = %v, want error", err)
+	}
+
+	other := types.NewPackage("other", "other")
+	named := types.NewNamed(types.NewTypeName(token.NoPos, other, "Kind", nil), types.Typ[types.Int], nil)
+	alias := types.NewTypeName(token.NoPos, tn.Pkg(), "OtherKind", named)
+	if _, err := resolveAlias(alias); err == nil || !strings.Contains(err.Error(), "another package") {
+		t.Errorf("resolveAlias() of an alias of other.Kind = %v, want error", err)
+	}
+}
+
+func TestIsInputFileOverlay(t *testing.T) {
+	// files in the overlay don't exist on disk, so they are compared by name
+	name := filepath.Join(t.TempDir(), "go_enumerator_stdin.go")
+	overlay := map[string][]byte{name: []byte("package example\n")}
+	if same, err := isInputFile(name, name, overlay); err != nil || !same {
+		t.Errorf("isInputFile() = %v, %v, want = true, nil", same, err)
+	}
+
+	if same, err := isInputFile(filepath.Join(t.TempDir(), "example.go"), name, overlay); err != nil || same {
+		t.Errorf("isInputFile() = %v, %v, want = false, nil", same, err)
+	}
+}
+
+func TestFindBuildConstraint(t *testing.T) {
+	tests := []struct {
+		src  string
+		want string
+	}{
+		{"//go:build linux && amd64\n\npackage example\n", "//go:build linux && amd64"},
+		{"// Copyright notice\n\n//go:build unix\n\npackage example\n", "//go:build unix"},
+		{"package example\n\n//go:build linux\n", ""},
+		{"package example\n", ""},
+	}
+
+	for _, tt := range tests {
+		_, _, syntax, _ := checkTestSource(t, tt.src)
+		if got := findBuildConstraint(syntax[0]); got != tt.want {
+			t.Errorf("findBuildConstraint(%q) = %q, want = %q", tt.src, got, tt.want)
+		}
+	}
+}
+
+func TestNoConstantsError(t *testing.T) {
+	src := `package example
+
+import "time"
+
+type Kind int
+
+type Color int
+
+type Shape int
+
+const (
+	ColorRed Color = iota
+	ColorBlue
+)
+
+const (
+	ShapeCircle Shape = iota
+	DefaultTimeout    = time.Second
+	MaxCount          = 10
+)
+`
+
+	// the input file must exist to be compared with the file declaring each constant
+	name := filepath.Join(t.TempDir(), "example.go")
+	if err := os.WriteFile(name, []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, name, src, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	info := &types.Info{Defs: make(map[*ast.Ident]types.Object)}
+	conf := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+	pkg, err := conf.Check("example", fset, []*ast.File{f}, info)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// time.Duration and untyped constants are not suggested
+	tn := pkg.Scope().Lookup("Kind").(*types.TypeName)
+	want := `no constants of type "Kind" found; types with constants in example.go: Color, Shape`
+	if err := noConstantsError(fset, info, tn, []string{name}, nil); err == nil || err.Error() != want {
+		t.Errorf("noConstantsError() = %v, want = %s", err, want)
+	}
+
+	// with --dir, there is no input file and the whole package is considered
+	want = `no constants of type "Kind" found; types with constants in package example: Color, Shape`
+	if err := noConstantsError(fset, info, tn, nil, nil); err == nil || err.Error() != want {
+		t.Errorf("noConstantsError() without an input file = %v, want = %s", err, want)
+	}
+}
+
+func TestFindConstantGroup(t *testing.T) {
+	src := `package example
+
+type Kind int
+
+const (
+	Red   = "red"
+	Green = "green"
+)
+
+const (
+	Small  = 1
+	Medium = 2.5
+)
+
+const (
+	Kind1 Kind = iota
+)
+
+const (
+	Low  int8 = 1
+	High int8 = 2
+)
+
+var Unrelated int
+`
+
+	// the input file must exist to be compared with the file of each declaration
+	name := filepath.Join(t.TempDir(), "example.go")
+	if err := os.WriteFile(name, []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, name, src, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	info := &types.Info{Defs: make(map[*ast.Ident]types.Object)}
+	if _, err := (&types.Config{}).Check("example", fset, []*ast.File{f}, info); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		line    int
+		want    string
+		wantErr string
+	}{
+		{4, "string", ""},
+		{9, "", "different types"},
+		{14, "", "--type=Kind"},
+		{18, "int8", ""},
+		{22, "", "requires a const block"},
+	}
+
+	for _, tt := range tests {
+		decl, basic, err := findConstantGroup(fset, info, []*ast.File{f}, name, nil, tt.line)
+		if tt.wantErr != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("findConstantGroup(%d) = %v, want error containing %q", tt.line, err, tt.wantErr)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("findConstantGroup(%d) = %v, want nil", tt.line, err)
+			continue
+		}
+
+		if basic.Name() != tt.want || fset.Position(decl.Pos()).Line != tt.line+1 {
+			t.Errorf("findConstantGroup(%d) = %s at line %d, want = %s at line %d", tt.line, basic, fset.Position(decl.Pos()).Line, tt.want, tt.line+1)
+		}
+	}
+}
@@ -0,0 +1,507 @@
+package enumgen
+
+import (
+	"fmt"
+	"go/constant"
+	"go/types"
+
+	"github.com/dave/jennifer/jen"
+)
+
+func generateTextMarshal(f *jen.File, receiver string, eType *types.TypeName, opts generateOptions) {
+	f.Commentf("MarshalText implements [encoding.TextMarshaler]")
+	if opts.MarshalStrict {
+		f.Commentf("An error is returned if !%s.Defined()", receiver)
+	}
+	f.Func().Params(jen.Id(receiver).Id(eType.Name())).Id("MarshalText").Params().Params(jen.Op("[]").Byte(), jen.Error()).BlockFunc(func(g *jen.Group) {
+		checkMarshalDefined(g, receiver, eType, opts)
+		g.Return(jen.Id(receiver).Dot("Bytes").Call(), jen.Nil())
+	})
+}
+
+// checkMarshalDefined adds statements to g that return an error if the receiver is not a defined value,
+// if opts.MarshalStrict is set. The method must return a byte slice and an error.
+func checkMarshalDefined(g *jen.Group, receiver string, eType *types.TypeName, opts generateOptions) {
+	if !opts.MarshalStrict {
+		return
+	}
+
+	g.If(jen.Op("!").Id(receiver).Dot("Defined").Call()).Block(
+		jen.Return(jen.Nil(), jen.Qual("fmt", "Errorf").Call(jen.Lit("cannot marshal undefined "+eType.Name()+" value: %v"), jen.Id(receiver))),
+	)
+	g.Line()
+}
+
+func generateTextUnmarshal(f *jen.File, receiver string, eType *types.TypeName, cs []constNameAndString, varName string, parse valueParser, opts generateOptions) {
+	success := []jen.Code{jen.Op("*").Id(receiver).Op("=").Id(parse.vVarName), jen.Return(jen.Nil())}
+
+	f.Commentf("UnmarshalText implements [encoding.TextUnmarshaler]")
+	if opts.TrimSpace {
+		f.Comment("")
+		f.Commentf("Leading and trailing spaces of %s are ignored.", varName)
+	}
+	if parse.numeric != nil {
+		f.Comment("")
+		f.Commentf("%s can also be a defined value written as an integer.", varName)
+	}
+	if opts.Flags {
+		f.Func().Params(jen.Id(receiver).Op("*").Id(eType.Name())).Id("UnmarshalText").Params(jen.Id(varName).Op("[]").Byte()).Params(jen.Error()).BlockFunc(func(g *jen.Group) {
+			trimSpace(g, varName, "bytes", opts)
+			parse.lookupFlags(g, eType, jen.String().Parens(jen.Id(varName)), parse.orNumber(eType, jen.String().Parens(jen.Id(varName)), success, jen.Return(invalidValueError(eType, jen.Id(parse.partVarName), opts)), opts))
+
+			g.Line()
+			g.Op("*").Id(receiver).Op("=").Id(parse.vVarName)
+			g.Return(jen.Nil())
+		})
+		return
+	}
+
+	if parse.usesMap() {
+		f.Func().Params(jen.Id(receiver).Op("*").Id(eType.Name())).Id("UnmarshalText").Params(jen.Id(varName).Op("[]").Byte()).Params(jen.Error()).BlockFunc(func(g *jen.Group) {
+			trimSpace(g, varName, "bytes", opts)
+			parse.lookup(g, jen.String().Parens(jen.Id(varName)), parse.orNumber(eType, jen.String().Parens(jen.Id(varName)), success, jen.Return(invalidValueError(eType, jen.String().Parens(jen.Id(varName)), opts)), opts))
+
+			g.Line()
+			g.Op("*").Id(receiver).Op("=").Id(parse.vVarName)
+			g.Return(jen.Nil())
+		})
+		return
+	}
+
+	f.Func().Params(jen.Id(receiver).Op("*").Id(eType.Name())).Id("UnmarshalText").Params(jen.Id(varName).Op("[]").Byte()).Params(jen.Error()).BlockFunc(func(g *jen.Group) {
+		trimSpace(g, varName, "bytes", opts)
+		// This call should be optimized by compiler: https://github.com/golang/go/issues/24937
+		g.Switch(jen.String().Parens(jen.Id(varName))).BlockFunc(func(g *jen.Group) {
+			for _, c := range cs {
+				g.Case(jen.Lit(c.String)).Block(jen.Op("*").Id(receiver).Op("=").Id(c.Name), jen.Return(jen.Nil()))
+			}
+			g.Default().Block(parse.orNumber(eType, jen.String().Parens(jen.Id(varName)), success, jen.Return(invalidValueError(eType, jen.String().Parens(jen.Id(varName)), opts)), opts))
+		})
+	})
+}
+
+func generateTextAppend(f *jen.File, receiver string, eType *types.TypeName, varName string, opts generateOptions) {
+	f.Commentf("AppendText implements [encoding.TextAppender]")
+	if opts.MarshalStrict {
+		f.Commentf("An error is returned if !%s.Defined()", receiver)
+	}
+	f.Func().Params(jen.Id(receiver).Id(eType.Name())).Id("AppendText").Params(jen.Id(varName).Op("[]").Byte()).Params(jen.Op("[]").Byte(), jen.Error()).BlockFunc(func(g *jen.Group) {
+		checkMarshalDefined(g, receiver, eType, opts)
+		g.Return(jen.Append(jen.Id(varName), jen.Id(receiver).Dot("String").Call().Op("...")), jen.Nil())
+	})
+}
+
+func generateJSONMarshal(f *jen.File, receiver string, eType *types.TypeName, opts generateOptions) {
+	f.Commentf("MarshalJSON implements [json.Marshaler]. %s is encoded as a JSON string using String()", receiver)
+	if opts.MarshalStrict {
+		f.Commentf("An error is returned if !%s.Defined()", receiver)
+	}
+	f.Func().Params(jen.Id(receiver).Id(eType.Name())).Id("MarshalJSON").Params().Params(jen.Op("[]").Byte(), jen.Error()).BlockFunc(func(g *jen.Group) {
+		checkMarshalDefined(g, receiver, eType, opts)
+		g.Return(jen.Qual("encoding/json", "Marshal").Call(jen.Id(receiver).Dot("String").Call()))
+	})
+}
+
+// generateGQLMarshal generates the MarshalGQL method of gqlgen's graphql.Marshaler interface.
+// The interface is satisfied structurally, so gqlgen doesn't need to be imported.
+func generateGQLMarshal(f *jen.File, receiver string, eType *types.TypeName, writerVarName string) {
+	f.Commentf("MarshalGQL implements the graphql.Marshaler interface of gqlgen. %s is written as a quoted string using String()", receiver)
+	f.Func().Params(jen.Id(receiver).Id(eType.Name())).Id("MarshalGQL").Params(jen.Id(writerVarName).Qual("io", "Writer")).Block(
+		jen.Qual("io", "WriteString").Call(jen.Id(writerVarName), jen.Qual("strconv", "Quote").Call(jen.Id(receiver).Dot("String").Call())),
+	)
+}
+
+// generateGQLUnmarshal generates the UnmarshalGQL method of gqlgen's graphql.Unmarshaler interface.
+// gqlgen passes enum values as strings, so any other type is an error.
+func generateGQLUnmarshal(f *jen.File, receiver string, eType *types.TypeName, vVarName string, strVarName string, okVarName string) {
+	f.Commentf("UnmarshalGQL implements the graphql.Unmarshaler interface of gqlgen. %s must be a string", vVarName)
+	f.Func().Params(jen.Id(receiver).Op("*").Id(eType.Name())).Id("UnmarshalGQL").Params(jen.Id(vVarName).Any()).Error().Block(
+		jen.List(jen.Id(strVarName), jen.Id(okVarName)).Op(":=").Id(vVarName).Assert(jen.String()),
+		jen.If(jen.Op("!").Id(okVarName)).Block(
+			jen.Return(jen.Qual("fmt", "Errorf").Call(jen.Lit(eType.Name()+" must be a string, got %T"), jen.Id(vVarName))),
+		),
+		jen.Line(),
+		jen.Return(jen.Id(receiver).Dot("UnmarshalText").Call(jen.Op("[]").Byte().Parens(jen.Id(strVarName)))),
+	)
+}
+
+func generateYAMLMarshal(f *jen.File, receiver string, eType *types.TypeName) {
+	f.Commentf("MarshalYAML implements the YAML marshaler interface. %s is encoded as a YAML string using String()", receiver)
+	f.Func().Params(jen.Id(receiver).Id(eType.Name())).Id("MarshalYAML").Params().Params(jen.Any(), jen.Error()).Block(
+		jen.Return(jen.Id(receiver).Dot("String").Call(), jen.Nil()),
+	)
+}
+
+// generateYAMLUnmarshal generates the UnmarshalYAML method using the API of the given
+// major version of the yaml package: yamlV2 for gopkg.in/yaml.v2, or yamlV3 for gopkg.in/yaml.v3.
+func generateYAMLUnmarshal(f *jen.File, receiver string, eType *types.TypeName, version yamlVersion, unmarshalVarName string, valueVarName string, strVarName string) {
+	f.Commentf("UnmarshalYAML implements the %s unmarshaler interface", yamlPackages[version])
+
+	var params, decode jen.Code
+	switch version {
+	case yamlV2:
+		params = jen.Id(unmarshalVarName).Func().Params(jen.Any()).Error()
+		decode = jen.Id(unmarshalVarName).Call(jen.Op("&").Id(strVarName))
+	default:
+		params = jen.Id(valueVarName).Op("*").Qual(yamlPackages[version], "Node")
+		decode = jen.Id(valueVarName).Dot("Decode").Call(jen.Op("&").Id(strVarName))
+	}
+
+	f.Func().Params(jen.Id(receiver).Op("*").Id(eType.Name())).Id("UnmarshalYAML").Params(params).Error().Block(
+		jen.Var().Id(strVarName).String(),
+		jen.If(jen.Err().Op(":=").Add(decode), jen.Err().Op("!=").Nil()).Block(
+			jen.Return(jen.Err()),
+		),
+		jen.Line(),
+		jen.Return(jen.Id(receiver).Dot("UnmarshalText").Call(jen.Op("[]").Byte().Parens(jen.Id(strVarName)))),
+	)
+}
+
+func generateJSONUnmarshal(f *jen.File, receiver string, eType *types.TypeName, varName string, strVarName string) {
+	f.Commentf("UnmarshalJSON implements [json.Unmarshaler]. JSON null values are ignored")
+	f.Func().Params(jen.Id(receiver).Op("*").Id(eType.Name())).Id("UnmarshalJSON").Params(jen.Id(varName).Op("[]").Byte()).Params(jen.Error()).Block(
+		jen.If(jen.String().Parens(jen.Id(varName)).Op("==").Lit("null")).Block(
+			jen.Return(jen.Nil()),
+		),
+		jen.Line(),
+		jen.Var().Id(strVarName).String(),
+		jen.If(jen.Err().Op(":=").Qual("encoding/json", "Unmarshal").Call(jen.Id(varName), jen.Op("&").Id(strVarName)), jen.Err().Op("!=").Nil()).Block(
+			jen.Return(jen.Err()),
+		),
+		jen.Line(),
+		jen.Return(jen.Id(receiver).Dot("UnmarshalText").Call(jen.Op("[]").Byte().Parens(jen.Id(strVarName)))),
+	)
+}
+
+func generateXMLMarshal(f *jen.File, receiver string, eType *types.TypeName, encoderVarName string, startVarName string) {
+	f.Commentf("MarshalXML implements [xml.Marshaler]. %s is encoded as element text using String()", receiver)
+	f.Func().Params(jen.Id(receiver).Id(eType.Name())).Id("MarshalXML").Params(jen.Id(encoderVarName).Op("*").Qual("encoding/xml", "Encoder"), jen.Id(startVarName).Qual("encoding/xml", "StartElement")).Error().Block(
+		jen.Return(jen.Id(encoderVarName).Dot("EncodeElement").Call(jen.Id(receiver).Dot("String").Call(), jen.Id(startVarName))),
+	)
+}
+
+func generateXMLUnmarshal(f *jen.File, receiver string, eType *types.TypeName, decoderVarName string, startVarName string, strVarName string) {
+	f.Commentf("UnmarshalXML implements [xml.Unmarshaler]")
+	f.Func().Params(jen.Id(receiver).Op("*").Id(eType.Name())).Id("UnmarshalXML").Params(jen.Id(decoderVarName).Op("*").Qual("encoding/xml", "Decoder"), jen.Id(startVarName).Qual("encoding/xml", "StartElement")).Error().Block(
+		jen.Var().Id(strVarName).String(),
+		jen.If(jen.Err().Op(":=").Id(decoderVarName).Dot("DecodeElement").Call(jen.Op("&").Id(strVarName), jen.Op("&").Id(startVarName)), jen.Err().Op("!=").Nil()).Block(
+			jen.Return(jen.Err()),
+		),
+		jen.Line(),
+		jen.Return(jen.Id(receiver).Dot("UnmarshalText").Call(jen.Op("[]").Byte().Parens(jen.Id(strVarName)))),
+	)
+}
+
+func generateXMLAttrMarshal(f *jen.File, receiver string, eType *types.TypeName, nameVarName string) {
+	f.Commentf("MarshalXMLAttr implements [xml.MarshalerAttr]. %s is encoded as the attribute value using String()", receiver)
+	f.Func().Params(jen.Id(receiver).Id(eType.Name())).Id("MarshalXMLAttr").Params(jen.Id(nameVarName).Qual("encoding/xml", "Name")).Params(jen.Qual("encoding/xml", "Attr"), jen.Error()).Block(
+		jen.Return(jen.Qual("encoding/xml", "Attr").Values(jen.Dict{
+			jen.Id("Name"):  jen.Id(nameVarName),
+			jen.Id("Value"): jen.Id(receiver).Dot("String").Call(),
+		}), jen.Nil()),
+	)
+}
+
+func generateXMLAttrUnmarshal(f *jen.File, receiver string, eType *types.TypeName, attrVarName string) {
+	f.Commentf("UnmarshalXMLAttr implements [xml.UnmarshalerAttr]")
+	f.Func().Params(jen.Id(receiver).Op("*").Id(eType.Name())).Id("UnmarshalXMLAttr").Params(jen.Id(attrVarName).Qual("encoding/xml", "Attr")).Error().Block(
+		jen.Return(jen.Id(receiver).Dot("UnmarshalText").Call(jen.Op("[]").Byte().Parens(jen.Id(attrVarName).Dot("Value")))),
+	)
+}
+
+func generateSQLValue(f *jen.File, receiver string, eType *types.TypeName, kind constant.Kind, basic *types.Basic) {
+	var value *jen.Statement
+	switch kind {
+	case constant.String:
+		value = jen.Id(receiver).Dot("String").Call()
+	case constant.Float:
+		value = jen.Float64().Parens(jen.Id(receiver))
+	default:
+		value = jen.Int64().Parens(jen.Id(receiver))
+	}
+
+	f.Commentf("Value implements [driver.Valuer]")
+	f.Func().Params(jen.Id(receiver).Id(eType.Name())).Id("Value").Params().Params(jen.Qual("database/sql/driver", "Value"), jen.Error()).BlockFunc(func(g *jen.Group) {
+		switch basic.Kind() {
+		case types.Uint, types.Uint64, types.Uintptr:
+			// driver.Value only supports int64, so large unsigned values cannot be stored
+			g.If(jen.Uint64().Parens(jen.Id(receiver)).Op(">").Qual("math", "MaxInt64")).Block(
+				jen.Return(jen.Nil(), jen.Qual("fmt", "Errorf").Call(jen.Lit(eType.Name()+" value %d overflows int64"), jen.Uint64().Parens(jen.Id(receiver)))),
+			)
+			g.Line()
+		}
+		g.Return(value, jen.Nil())
+	})
+}
+
+func generateSQLScan(f *jen.File, receiver string, eType *types.TypeName, kind constant.Kind, srcVarName string, vVarName string) {
+	f.Commentf("Scan implements [sql.Scanner]")
+	f.Func().Params(jen.Id(receiver).Op("*").Id(eType.Name())).Id("Scan").Params(jen.Id(srcVarName).Any()).Error().Block(
+		jen.Switch(jen.Id(srcVarName).Op(":=").Id(srcVarName).Assert(jen.Type())).BlockFunc(func(g *jen.Group) {
+			g.Case(jen.String()).Block(
+				jen.Return(jen.Id(receiver).Dot("UnmarshalText").Call(jen.Op("[]").Byte().Parens(jen.Id(srcVarName)))),
+			)
+			g.Case(jen.Op("[]").Byte()).Block(
+				jen.Return(jen.Id(receiver).Dot("UnmarshalText").Call(jen.Id(srcVarName))),
+			)
+			switch kind {
+			case constant.Int:
+				g.Case(jen.Int64()).Block(
+					// the conversion truncates values that don't fit in the underlying type, so they must round-trip.
+					// The value is assigned before calling Defined, which may have a pointer receiver
+					jen.If(jen.Id(vVarName).Op(":=").Id(eType.Name()).Parens(jen.Id(srcVarName)), jen.Int64().Parens(jen.Id(vVarName)).Op("!=").Id(srcVarName).Op("||").Op("!").Id(vVarName).Dot("Defined").Call()).Block(
+						jen.Return(jen.Qual("fmt", "Errorf").Call(jen.Lit("unknown "+eType.Name()+" value: %d"), jen.Id(srcVarName))),
+					),
+					jen.Op("*").Id(receiver).Op("=").Id(eType.Name()).Parens(jen.Id(srcVarName)),
+					jen.Return(jen.Nil()),
+				)
+			case constant.Float:
+				g.Case(jen.Float64()).Block(
+					// the value is assigned before calling Defined, which may have a pointer receiver
+					jen.If(jen.Id(vVarName).Op(":=").Id(eType.Name()).Parens(jen.Id(srcVarName)), jen.Op("!").Id(vVarName).Dot("Defined").Call()).Block(
+						jen.Return(jen.Qual("fmt", "Errorf").Call(jen.Lit("unknown "+eType.Name()+" value: %g"), jen.Id(srcVarName))),
+					),
+					jen.Op("*").Id(receiver).Op("=").Id(eType.Name()).Parens(jen.Id(srcVarName)),
+					jen.Return(jen.Nil()),
+				)
+			}
+			g.Default().Block(
+				jen.Return(jen.Qual("fmt", "Errorf").Call(jen.Lit("cannot scan %T into "+eType.Name()), jen.Id(srcVarName))),
+			)
+		}),
+	)
+}
+
+// binaryUintType returns the name of the unsigned integer type used to encode
+// values whose underlying type is basic, along with its size in bytes.
+func binaryUintType(basic *types.Basic) (string, int) {
+	switch basic.Kind() {
+	case types.Int8, types.Uint8:
+		return "uint8", 1
+	case types.Int16, types.Uint16:
+		return "uint16", 2
+	case types.Int32, types.Uint32, types.Float32:
+		return "uint32", 4
+	default:
+		// int, uint and uintptr always use 8 bytes so the encoding doesn't depend on the platform
+		return "uint64", 8
+	}
+}
+
+func generateBinaryMarshal(f *jen.File, receiver string, eType *types.TypeName, basic *types.Basic) {
+	f.Commentf("MarshalBinary implements [encoding.BinaryMarshaler]")
+	f.Func().Params(jen.Id(receiver).Id(eType.Name())).Id("MarshalBinary").Params().Params(jen.Op("[]").Byte(), jen.Error()).BlockFunc(func(g *jen.Group) {
+		if basic.Info()&types.IsString != 0 {
+			g.Return(jen.Id(receiver).Dot("MarshalText").Call())
+			return
+		}
+
+		uintType, size := binaryUintType(basic)
+
+		var bits *jen.Statement
+		switch basic.Kind() {
+		case types.Float32:
+			bits = jen.Qual("math", "Float32bits").Call(jen.Float32().Parens(jen.Id(receiver)))
+		case types.Float64:
+			bits = jen.Qual("math", "Float64bits").Call(jen.Float64().Parens(jen.Id(receiver)))
+		default:
+			bits = jen.Id(uintType).Parens(jen.Id(receiver))
+		}
+
+		if size == 1 {
+			g.Return(jen.Op("[]").Byte().Values(bits), jen.Nil())
+			return
+		}
+
+		g.Return(jen.Qual("encoding/binary", "BigEndian").Dot(fmt.Sprintf("AppendUint%d", size*8)).Call(jen.Nil(), bits), jen.Nil())
+	})
+}
+
+func generateBinaryUnmarshal(f *jen.File, receiver string, eType *types.TypeName, basic *types.Basic, varName string, vVarName string) {
+	f.Commentf("UnmarshalBinary implements [encoding.BinaryUnmarshaler]")
+	f.Func().Params(jen.Id(receiver).Op("*").Id(eType.Name())).Id("UnmarshalBinary").Params(jen.Id(varName).Op("[]").Byte()).Error().BlockFunc(func(g *jen.Group) {
+		if basic.Info()&types.IsString != 0 {
+			g.Return(jen.Id(receiver).Dot("UnmarshalText").Call(jen.Id(varName)))
+			return
+		}
+
+		_, size := binaryUintType(basic)
+
+		var bits *jen.Statement
+		if size == 1 {
+			bits = jen.Id(varName).Index(jen.Lit(0))
+		} else {
+			bits = jen.Qual("encoding/binary", "BigEndian").Dot(fmt.Sprintf("Uint%d", size*8)).Call(jen.Id(varName))
+		}
+
+		switch basic.Kind() {
+		case types.Float32:
+			bits = jen.Qual("math", "Float32frombits").Call(bits)
+		case types.Float64:
+			bits = jen.Qual("math", "Float64frombits").Call(bits)
+		}
+
+		g.If(jen.Len(jen.Id(varName)).Op("!=").Lit(size)).Block(
+			jen.Return(jen.Qual("fmt", "Errorf").Call(jen.Lit(fmt.Sprintf("invalid %s binary length %%d: expected %d", eType.Name(), size)), jen.Len(jen.Id(varName)))),
+		)
+		g.Line()
+		g.Id(vVarName).Op(":=").Id(eType.Name()).Parens(bits)
+		g.If(jen.Op("!").Id(vVarName).Dot("Defined").Call()).Block(
+			jen.Return(jen.Qual("fmt", "Errorf").Call(jen.Lit("unknown "+eType.Name()+" value: %v"), jen.Id(basic.Name()).Parens(jen.Id(vVarName)))),
+		)
+		g.Line()
+		g.Op("*").Id(receiver).Op("=").Id(vVarName)
+		g.Return(jen.Nil())
+	})
+}
+
+// generateMsgpackStringMarshal generates the MarshalMsgpack method of the msgpack.Marshaler interface of
+// github.com/vmihailenco/msgpack, which returns the encoded value. The interface is satisfied structurally,
+// so msgpack doesn't need to be imported, and the string is encoded with the smallest msgpack str format.
+func generateMsgpackStringMarshal(f *jen.File, receiver string, eType *types.TypeName, strVarName string, nVarName string) {
+	f.Commentf("MarshalMsgpack implements the msgpack.Marshaler interface of github.com/vmihailenco/msgpack. %s is encoded as a msgpack string using String()", receiver)
+	f.Func().Params(jen.Id(receiver).Id(eType.Name())).Id("MarshalMsgpack").Params().Params(jen.Op("[]").Byte(), jen.Error()).Block(
+		jen.Id(strVarName).Op(":=").Id(receiver).Dot("String").Call(),
+		jen.Switch(jen.Id(nVarName).Op(":=").Len(jen.Id(strVarName)), jen.Empty()).Block(
+			jen.Case(jen.Id(nVarName).Op("<").Lit(32)).Block(
+				jen.Return(jen.Append(jen.Op("[]").Byte().Values(jen.Op("0xa0").Op("|").Byte().Parens(jen.Id(nVarName))), jen.Id(strVarName).Op("...")), jen.Nil()),
+			),
+			jen.Case(jen.Id(nVarName).Op("<=").Qual("math", "MaxUint8")).Block(
+				jen.Return(jen.Append(jen.Op("[]").Byte().Values(jen.Op("0xd9"), jen.Byte().Parens(jen.Id(nVarName))), jen.Id(strVarName).Op("...")), jen.Nil()),
+			),
+			jen.Case(jen.Id(nVarName).Op("<=").Qual("math", "MaxUint16")).Block(
+				jen.Return(jen.Append(jen.Qual("encoding/binary", "BigEndian").Dot("AppendUint16").Call(jen.Op("[]").Byte().Values(jen.Op("0xda")), jen.Uint16().Parens(jen.Id(nVarName))), jen.Id(strVarName).Op("...")), jen.Nil()),
+			),
+			jen.Default().Block(
+				jen.Return(jen.Append(jen.Qual("encoding/binary", "BigEndian").Dot("AppendUint32").Call(jen.Op("[]").Byte().Values(jen.Op("0xdb")), jen.Uint32().Parens(jen.Id(nVarName))), jen.Id(strVarName).Op("...")), jen.Nil()),
+			),
+		),
+	)
+}
+
+// generateMsgpackStringUnmarshal generates the UnmarshalMsgpack method of the msgpack.Unmarshaler interface of
+// github.com/vmihailenco/msgpack, which is passed the encoded value. Any msgpack str format is accepted.
+func generateMsgpackStringUnmarshal(f *jen.File, receiver string, eType *types.TypeName, varName string, nVarName string, sizeVarName string) {
+	header := func(b string, size int) jen.Code {
+		return jen.Len(jen.Id(varName)).Op(">=").Lit(size).Op("&&").Id(varName).Index(jen.Lit(0)).Op("==").Op(b)
+	}
+
+	f.Commentf("UnmarshalMsgpack implements the msgpack.Unmarshaler interface of github.com/vmihailenco/msgpack. %s must be a msgpack string", varName)
+	f.Func().Params(jen.Id(receiver).Op("*").Id(eType.Name())).Id("UnmarshalMsgpack").Params(jen.Id(varName).Op("[]").Byte()).Error().Block(
+		jen.Var().List(jen.Id(nVarName), jen.Id(sizeVarName)).Int(),
+		jen.Switch().Block(
+			jen.Case(jen.Len(jen.Id(varName)).Op(">=").Lit(1).Op("&&").Id(varName).Index(jen.Lit(0)).Op("&").Op("0xe0").Op("==").Op("0xa0")).Block(
+				jen.List(jen.Id(nVarName), jen.Id(sizeVarName)).Op("=").List(jen.Int().Parens(jen.Id(varName).Index(jen.Lit(0)).Op("&").Op("0x1f")), jen.Lit(1)),
+			),
+			jen.Case(header("0xd9", 2)).Block(
+				jen.List(jen.Id(nVarName), jen.Id(sizeVarName)).Op("=").List(jen.Int().Parens(jen.Id(varName).Index(jen.Lit(1))), jen.Lit(2)),
+			),
+			jen.Case(header("0xda", 3)).Block(
+				jen.List(jen.Id(nVarName), jen.Id(sizeVarName)).Op("=").List(jen.Int().Parens(jen.Qual("encoding/binary", "BigEndian").Dot("Uint16").Call(jen.Id(varName).Index(jen.Lit(1), jen.Empty()))), jen.Lit(3)),
+			),
+			jen.Case(header("0xdb", 5)).Block(
+				jen.List(jen.Id(nVarName), jen.Id(sizeVarName)).Op("=").List(jen.Int().Parens(jen.Qual("encoding/binary", "BigEndian").Dot("Uint32").Call(jen.Id(varName).Index(jen.Lit(1), jen.Empty()))), jen.Lit(5)),
+			),
+			jen.Default().Block(
+				jen.Return(jen.Qual("errors", "New").Call(jen.Lit(eType.Name()+" must be a msgpack string"))),
+			),
+		),
+		jen.Line(),
+		jen.If(jen.Len(jen.Id(varName)).Op("!=").Id(sizeVarName).Op("+").Id(nVarName)).Block(
+			jen.Return(jen.Qual("fmt", "Errorf").Call(jen.Lit(fmt.Sprintf("invalid %s msgpack string length %%d: expected %%d", eType.Name())), jen.Len(jen.Id(varName)).Op("-").Id(sizeVarName), jen.Id(nVarName))),
+		),
+		jen.Line(),
+		jen.Return(jen.Id(receiver).Dot("UnmarshalText").Call(jen.Id(varName).Index(jen.Id(sizeVarName), jen.Empty()))),
+	)
+}
+
+// generateMsgpackIntMarshal generates the MarshalMsgpack method of the msgpack.Marshaler interface for --msgpack=int,
+// which encodes the underlying value as a msgpack fixint when it fits, or as a 64-bit msgpack int otherwise.
+func generateMsgpackIntMarshal(f *jen.File, receiver string, eType *types.TypeName, basic *types.Basic, nVarName string) {
+	f.Commentf("MarshalMsgpack implements the msgpack.Marshaler interface of github.com/vmihailenco/msgpack. %s is encoded as a msgpack integer", receiver)
+	f.Func().Params(jen.Id(receiver).Id(eType.Name())).Id("MarshalMsgpack").Params().Params(jen.Op("[]").Byte(), jen.Error()).BlockFunc(func(g *jen.Group) {
+		if basic.Info()&types.IsUnsigned != 0 {
+			g.Id(nVarName).Op(":=").Uint64().Parens(jen.Id(receiver))
+			g.If(jen.Id(nVarName).Op("<=").Op("0x7f")).Block(
+				jen.Return(jen.Op("[]").Byte().Values(jen.Byte().Parens(jen.Id(nVarName))), jen.Nil()),
+			)
+			g.Line()
+			g.Return(jen.Qual("encoding/binary", "BigEndian").Dot("AppendUint64").Call(jen.Op("[]").Byte().Values(jen.Op("0xcf")), jen.Id(nVarName)), jen.Nil())
+			return
+		}
+
+		g.Id(nVarName).Op(":=").Int64().Parens(jen.Id(receiver))
+		g.If(jen.Id(nVarName).Op(">=").Lit(-32).Op("&&").Id(nVarName).Op("<=").Op("0x7f")).Block(
+			jen.Return(jen.Op("[]").Byte().Values(jen.Byte().Parens(jen.Id(nVarName))), jen.Nil()),
+		)
+		g.Line()
+		g.Return(jen.Qual("encoding/binary", "BigEndian").Dot("AppendUint64").Call(jen.Op("[]").Byte().Values(jen.Op("0xd3")), jen.Uint64().Parens(jen.Id(nVarName))), jen.Nil())
+	})
+}
+
+// generateMsgpackIntUnmarshal generates the UnmarshalMsgpack method of the msgpack.Unmarshaler interface for --msgpack=int.
+// Any msgpack int or uint format is accepted, as long as the value is defined.
+func generateMsgpackIntUnmarshal(f *jen.File, receiver string, eType *types.TypeName, basic *types.Basic, varName string, nVarName string, vVarName string, bVarName string, cVarName string, uVarName string) {
+	// the uint 8 to 64 formats are 0xcc to 0xcf and the int ones 0xd0 to 0xd3, so the low bits give the size
+	sized := jen.Len(jen.Id(varName)).Op("==").Lit(1).Op("+").Lit(1).Op("<<").Parens(jen.Id(bVarName).Op("&").Lit(3))
+	invalid := jen.Return(jen.Qual("errors", "New").Call(jen.Lit(eType.Name() + " must be a msgpack integer")))
+	unknown := jen.Return(jen.Qual("fmt", "Errorf").Call(jen.Lit("unknown "+eType.Name()+" value: %v"), jen.Id(nVarName)))
+
+	f.Commentf("UnmarshalMsgpack implements the msgpack.Unmarshaler interface of github.com/vmihailenco/msgpack. %s must be a msgpack integer", varName)
+	f.Func().Params(jen.Id(receiver).Op("*").Id(eType.Name())).Id("UnmarshalMsgpack").Params(jen.Id(varName).Op("[]").Byte()).Error().BlockFunc(func(g *jen.Group) {
+		g.If(jen.Len(jen.Id(varName)).Op("==").Lit(0)).Block(invalid.Clone())
+		g.Line()
+
+		wide := jen.Int64()
+		if basic.Info()&types.IsUnsigned != 0 {
+			wide = jen.Uint64()
+
+			// negative values can't be converted, so the sign bit of the int formats must be clear
+			g.Var().Id(nVarName).Uint64()
+			g.Switch(jen.Id(bVarName).Op(":=").Id(varName).Index(jen.Lit(0)), jen.Empty()).Block(
+				jen.Case(jen.Len(jen.Id(varName)).Op("==").Lit(1).Op("&&").Id(bVarName).Op("<=").Op("0x7f")).Block(
+					jen.Id(nVarName).Op("=").Uint64().Parens(jen.Id(bVarName)),
+				),
+				jen.Case(jen.Id(bVarName).Op(">=").Op("0xcc").Op("&&").Id(bVarName).Op("<=").Op("0xd3").Op("&&").Add(sized).Op("&&").Parens(jen.Id(bVarName).Op("<=").Op("0xcf").Op("||").Id(varName).Index(jen.Lit(1)).Op("<").Op("0x80"))).Block(
+					jen.For(jen.List(jen.Id("_"), jen.Id(cVarName)).Op(":=").Range().Id(varName).Index(jen.Lit(1), jen.Empty())).Block(
+						jen.Id(nVarName).Op("=").Id(nVarName).Op("<<").Lit(8).Op("|").Uint64().Parens(jen.Id(cVarName)),
+					),
+				),
+				jen.Default().Block(invalid.Clone()),
+			)
+		} else {
+			g.Var().Id(nVarName).Int64()
+			g.Switch(jen.Id(bVarName).Op(":=").Id(varName).Index(jen.Lit(0)), jen.Empty()).Block(
+				jen.Case(jen.Len(jen.Id(varName)).Op("==").Lit(1).Op("&&").Parens(jen.Id(bVarName).Op("<=").Op("0x7f").Op("||").Id(bVarName).Op(">=").Op("0xe0"))).Block(
+					jen.Comment("positive and negative fixints"),
+					jen.Id(nVarName).Op("=").Int64().Parens(jen.Int8().Parens(jen.Id(bVarName))),
+				),
+				jen.Case(jen.Id(bVarName).Op(">=").Op("0xcc").Op("&&").Id(bVarName).Op("<=").Op("0xcf").Op("&&").Add(sized.Clone())).Block(
+					jen.Var().Id(uVarName).Uint64(),
+					jen.For(jen.List(jen.Id("_"), jen.Id(cVarName)).Op(":=").Range().Id(varName).Index(jen.Lit(1), jen.Empty())).Block(
+						jen.Id(uVarName).Op("=").Id(uVarName).Op("<<").Lit(8).Op("|").Uint64().Parens(jen.Id(cVarName)),
+					),
+					jen.If(jen.Id(uVarName).Op(">").Qual("math", "MaxInt64")).Block(
+						jen.Return(jen.Qual("fmt", "Errorf").Call(jen.Lit("unknown "+eType.Name()+" value: %v"), jen.Id(uVarName))),
+					),
+					jen.Id(nVarName).Op("=").Int64().Parens(jen.Id(uVarName)),
+				),
+				jen.Case(jen.Id(bVarName).Op(">=").Op("0xd0").Op("&&").Id(bVarName).Op("<=").Op("0xd3").Op("&&").Add(sized.Clone())).Block(
+					jen.Comment("the first byte carries the sign"),
+					jen.Id(nVarName).Op("=").Int64().Parens(jen.Int8().Parens(jen.Id(varName).Index(jen.Lit(1)))),
+					jen.For(jen.List(jen.Id("_"), jen.Id(cVarName)).Op(":=").Range().Id(varName).Index(jen.Lit(2), jen.Empty())).Block(
+						jen.Id(nVarName).Op("=").Id(nVarName).Op("<<").Lit(8).Op("|").Int64().Parens(jen.Id(cVarName)),
+					),
+				),
+				jen.Default().Block(invalid.Clone()),
+			)
+		}
+
+		g.Line()
+		g.Id(vVarName).Op(":=").Id(eType.Name()).Parens(jen.Id(nVarName))
+		g.If(wide.Parens(jen.Id(vVarName)).Op("!=").Id(nVarName).Op("||").Op("!").Id(vVarName).Dot("Defined").Call()).Block(unknown)
+		g.Line()
+		g.Op("*").Id(receiver).Op("=").Id(vVarName)
+		g.Return(jen.Nil())
+	})
+}
@@ -0,0 +1,139 @@
+package enumgen
+
+import (
+	"go/token"
+	"go/types"
+	"strings"
+	"testing"
+)
+
+func TestGenerateTextAppend(t *testing.T) {
+	tn, cs, kind := newTestEnum("Kind", types.Int, []string{"Kind1", "Kind2"}, []any{int64(0), int64(1)})
+
+	got := renderTestEnum(t, tn, cs, kind, generateOptions{TextAppender: true})
+	for _, want := range []string{
+		"func (k Kind) AppendText(b []byte) ([]byte, error) {",
+		"return append(b, k.String()...), nil",
+		"_ encoding.TextAppender = Kind(0)",
+	} {
+		if !containsCode(got, want) {
+			t.Errorf("generated code does not contain %q", want)
+		}
+	}
+
+	got = renderTestEnum(t, tn, cs, kind, generateOptions{})
+	if strings.Contains(got, "AppendText") {
+		t.Errorf("generated code contains AppendText when it was not requested")
+	}
+}
+
+func TestGenerateMsgpack(t *testing.T) {
+	tn, cs, kind := newTestEnum("Kind", types.Int, []string{"KindA", "KindB"}, []any{int64(0), int64(1)})
+
+	got := renderTestEnum(t, tn, cs, kind, generateOptions{Msgpack: msgpackString})
+	for _, want := range []string{
+		"func (k Kind) MarshalMsgpack() ([]byte, error)",
+		"return append([]byte{0xa0 | byte(n)}, str...), nil",
+		"return k.UnmarshalText(x[size:])",
+	} {
+		if !containsCode(got, want) {
+			t.Errorf("--msgpack=string does not contain %q:\n%s", want, got)
+		}
+	}
+
+	got = renderTestEnum(t, tn, cs, kind, generateOptions{Msgpack: msgpackInt})
+	for _, want := range []string{
+		"n := int64(k)",
+		"binary.BigEndian.AppendUint64([]byte{0xd3}, uint64(n))",
+		"func (k *Kind) UnmarshalMsgpack(x []byte) error",
+	} {
+		if !containsCode(got, want) {
+			t.Errorf("--msgpack=int does not contain %q:\n%s", want, got)
+		}
+	}
+
+	tn, cs, kind = newTestEnum("Kind", types.Uint8, []string{"KindA", "KindB"}, []any{int64(0), int64(200)})
+	got = renderTestEnum(t, tn, cs, kind, generateOptions{Msgpack: msgpackInt})
+	if want := "binary.BigEndian.AppendUint64([]byte{0xcf}, n)"; !containsCode(got, want) {
+		t.Errorf("--msgpack=int on an unsigned type does not contain %q:\n%s", want, got)
+	}
+
+	tn, cs, kind = newTestEnum("Kind", types.String, []string{"KindA", "KindB"}, []any{"a", "b"})
+	if _, err := generateEnumCode(token.NewFileSet(), "example", tn, cs, kind, defaultReceiverName(tn, receiverInitial), "go-enumerator", generateOptions{Msgpack: msgpackInt}); err == nil {
+		t.Errorf("--msgpack=int on a string type did not fail")
+	}
+}
+
+func TestGenerateYAMLUnmarshal(t *testing.T) {
+	tn, cs, kind := newTestEnum("Kind", types.Int, []string{"Kind1", "Kind2"}, []any{int64(0), int64(1)})
+
+	got := renderTestEnum(t, tn, cs, kind, generateOptions{YAML: yamlV2})
+	if want := "func (k *Kind) UnmarshalYAML(unmarshal func(any) error) error"; !containsCode(got, want) {
+		t.Errorf("generated code does not contain %q:\n%s", want, got)
+	}
+
+	// the v2 API doesn't need the yaml package
+	if strings.Contains(got, `"gopkg.in/yaml`) {
+		t.Errorf("generated code imports the yaml package:\n%s", got)
+	}
+
+	got = renderTestEnum(t, tn, cs, kind, generateOptions{YAML: yamlV3})
+	if want := "func (k *Kind) UnmarshalYAML(value *yaml.Node) error"; !containsCode(got, want) {
+		t.Errorf("generated code does not contain %q:\n%s", want, got)
+	}
+}
+
+func TestGenerateMarshalStrict(t *testing.T) {
+	tn, cs, kind := newTestEnum("Kind", types.Int, []string{"KindA", "KindB"}, []any{int64(0), int64(1)})
+	undefined := `return nil, fmt.Errorf("cannot marshal undefined Kind value: %v", k)`
+
+	got := renderTestEnum(t, tn, cs, kind, generateOptions{JSON: true, TextAppender: true})
+	if containsCode(got, undefined) {
+		t.Errorf("marshalers check for undefined values without --marshal-strict:\n%s", got)
+	}
+
+	got = renderTestEnum(t, tn, cs, kind, generateOptions{JSON: true, TextAppender: true, MarshalStrict: true})
+	if n := strings.Count(got, undefined); n != 3 {
+		t.Errorf("MarshalText, AppendText and MarshalJSON check for undefined values %d times, want 3:\n%s", n, got)
+	}
+}
+
+func TestGenerateXML(t *testing.T) {
+	// the receiver of Element is e, so the encoder must be named differently
+	tn, cs, kind := newTestEnum("Element", types.Int, []string{"Element1", "Element2"}, []any{int64(0), int64(1)})
+
+	got := renderTestEnum(t, tn, cs, kind, generateOptions{XML: true, XMLAttr: true})
+	for _, want := range []string{
+		"func (e Element) MarshalXML(_e *xml.Encoder, start xml.StartElement) error",
+		"func (e *Element) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error",
+		"func (e Element) MarshalXMLAttr(name xml.Name) (xml.Attr, error)",
+		"func (e *Element) UnmarshalXMLAttr(attr xml.Attr) error",
+		"_ xml.UnmarshalerAttr = new(Element)",
+	} {
+		if !containsCode(got, want) {
+			t.Errorf("generated code does not contain %q:\n%s", want, got)
+		}
+	}
+}
+
+func TestGenerateGQLGen(t *testing.T) {
+	// the receiver of Visibility is v, so the argument of UnmarshalGQL must be named differently
+	tn, cs, kind := newTestEnum("Visibility", types.Int, []string{"Visibility1", "Visibility2"}, []any{int64(0), int64(1)})
+
+	got := renderTestEnum(t, tn, cs, kind, generateOptions{GQLGen: true})
+	for _, want := range []string{
+		"func (v Visibility) MarshalGQL(w io.Writer)",
+		"io.WriteString(w, strconv.Quote(v.String()))",
+		"func (v *Visibility) UnmarshalGQL(_v any) error",
+		"str, ok := _v.(string)",
+		"UnmarshalGQL(any) error",
+	} {
+		if !containsCode(got, want) {
+			t.Errorf("generated code does not contain %q:\n%s", want, got)
+		}
+	}
+
+	if strings.Contains(got, "gqlgen\"") {
+		t.Errorf("generated code imports gqlgen:\n%s", got)
+	}
+}
@@ -0,0 +1,589 @@
+package enumgen
+
+import (
+	"fmt"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/dave/jennifer/jen"
+)
+
+// generateNextMethod generates the Next() method for the enum.
+func generateNextMethod(f *jen.File, tn *types.TypeName, receiver string, cs []constNameAndString, basic *types.Basic, opts generateOptions) {
+	zero := zeroValue(basic).GoString()
+	name := methodName(tn, "Next", opts)
+
+	f.Commentf("%s returns the next defined %s. If %s is not defined, then %s returns the first defined value.", name, tn.Name(), receiver, name)
+	f.Commentf("%s() can be used to loop through all values of an enum.", name)
+	f.Commentf("")
+	f.Commentf("\t%s := %s(%v)", receiver, tn.Name(), zero)
+	f.Comment("\tfor {")
+	f.Commentf("\t\tfmt.Println(%s)", receiver)
+	f.Commentf("\t\t%s = %s", receiver, methodCall(receiver, tn, "Next", opts).GoString())
+	f.Commentf("\t\tif %s == %s(%v) {", receiver, tn.Name(), zero)
+	f.Comment("\t\t\tbreak")
+	f.Comment("\t\t}")
+	f.Comment("\t}")
+	f.Commentf("")
+	f.Commentf("The exact order that values are returned when looping should not be relied upon.")
+	if opts.Iter {
+		f.Commentf("Ranging over %sAll() is a simpler way to loop through all values.", tn.Name())
+	}
+	methodDecl(f, receiverParam(receiver, tn, opts), tn, "Next", opts).Add(typeRef(tn)).Block(
+		jen.Switch(receiverValue(receiver, opts)).BlockFunc(func(g *jen.Group) {
+			for i, c := range cs {
+				ni := (i + 1) % len(cs)
+				g.Case(constRef(c)).Block(jen.Return(constRef(cs[ni])))
+			}
+			if len(cs) > 0 {
+				g.Default().Block(jen.Return(constRef(cs[0])))
+			}
+		}),
+	)
+}
+
+// generatePrevMethod generates the Prev() method for the enum.
+func generatePrevMethod(f *jen.File, tn *types.TypeName, receiver string, cs []constNameAndString, basic *types.Basic, opts generateOptions) {
+	zero := zeroValue(basic).GoString()
+	name := methodName(tn, "Prev", opts)
+
+	f.Commentf("%s returns the previous defined %s. If %s is not defined, then %s returns the last defined value.", name, tn.Name(), receiver, name)
+	f.Commentf("%s() can be used to loop through all values of an enum in reverse.", name)
+	f.Commentf("")
+	f.Commentf("\t%s := %s(%v)", receiver, tn.Name(), zero)
+	f.Comment("\tfor {")
+	f.Commentf("\t\tfmt.Println(%s)", receiver)
+	f.Commentf("\t\t%s = %s", receiver, methodCall(receiver, tn, "Prev", opts).GoString())
+	f.Commentf("\t\tif %s == %s(%v) {", receiver, tn.Name(), zero)
+	f.Comment("\t\t\tbreak")
+	f.Comment("\t\t}")
+	f.Comment("\t}")
+	f.Commentf("")
+	f.Commentf("The exact order that values are returned when looping should not be relied upon.")
+	methodDecl(f, receiverParam(receiver, tn, opts), tn, "Prev", opts).Add(typeRef(tn)).Block(
+		jen.Switch(receiverValue(receiver, opts)).BlockFunc(func(g *jen.Group) {
+			for i, c := range cs {
+				pi := (i + len(cs) - 1) % len(cs)
+				g.Case(constRef(c)).Block(jen.Return(constRef(cs[pi])))
+			}
+			if len(cs) > 0 {
+				g.Default().Block(jen.Return(constRef(cs[len(cs)-1])))
+			}
+		}),
+	)
+}
+
+// generateCountMethod generates the _<Type>Count constant and the <Type>Count() function for the enum.
+// The constant can be used where a compile-time constant is required, such as array lengths.
+func generateCountMethod(f *jen.File, tn *types.TypeName, cs []constNameAndString, opts generateOptions) {
+	f.Commentf("_%sCount is the number of defined %s values.", tn.Name(), tn.Name())
+	f.Const().Id("_" + tn.Name() + "Count").Op("=").Lit(len(cs))
+
+	f.Line()
+	values := tn.Name() + "Values()"
+	if opts.Values == valuesArray {
+		values = tn.Name() + "Values"
+	}
+
+	f.Commentf("%sCount returns the number of defined %s values, which is len(%s).", tn.Name(), tn.Name(), values)
+	f.Func().Id(tn.Name() + "Count").Params().Int().Block(
+		jen.Return(jen.Id("_" + tn.Name() + "Count")),
+	)
+}
+
+// generateMinMaxConstants generates the <Type>Min and <Type>Max constants for the enum,
+// which refer to the constants in cs with the smallest and largest values.
+// The first declared constant is used if several have the same value.
+func generateMinMaxConstants(f *jen.File, tn *types.TypeName, cs []constNameAndString) {
+	lo, hi := cs[0], cs[0]
+	for _, c := range cs[1:] {
+		if constant.Compare(c.Const.Val(), token.LSS, lo.Const.Val()) {
+			lo = c
+		}
+
+		if constant.Compare(c.Const.Val(), token.GTR, hi.Const.Val()) {
+			hi = c
+		}
+	}
+
+	f.Const().Defs(
+		jen.Commentf("%sMin is the defined %s with the smallest value", tn.Name(), tn.Name()),
+		jen.Id(tn.Name()+"Min").Op("=").Add(constRef(lo)),
+		jen.Line(),
+		jen.Commentf("%sMax is the defined %s with the largest value", tn.Name(), tn.Name()),
+		jen.Id(tn.Name()+"Max").Op("=").Add(constRef(hi)),
+	)
+}
+
+// generateProtoMaps generates the <Type>NameMap and <Type>ValueMap variables for the enum, which follow the
+// conventions of the <Enum>_name and <Enum>_value maps generated for protobuf enums. Like those, the name map
+// only holds the first declared constant of each value, while the value map holds all of them.
+func generateProtoMaps(f *jen.File, tn *types.TypeName, cs, canonical []constNameAndString) {
+	f.Commentf("%sNameMap maps the values of %s to their string representations, like the <Enum>_name map of a protobuf enum.", tn.Name(), tn.Name())
+	f.Var().Id(tn.Name() + "NameMap").Op("=").Map(jen.Int32()).String().Values(jen.DictFunc(func(d jen.Dict) {
+		for _, c := range canonical {
+			d[jen.Op(c.Const.Val().ExactString())] = jen.Lit(c.String)
+		}
+	}))
+
+	f.Line()
+	f.Commentf("%sValueMap maps the string representations of %s to their values, like the <Enum>_value map of a protobuf enum.", tn.Name(), tn.Name())
+	f.Var().Id(tn.Name() + "ValueMap").Op("=").Map(jen.String()).Int32().Values(jen.DictFunc(func(d jen.Dict) {
+		for _, c := range cs {
+			d[jen.Lit(c.String)] = jen.Op(c.Const.Val().ExactString())
+		}
+	}))
+}
+
+// generateOrdinalMethod generates the Ordinal() method and the <Type>FromOrdinal() function for the enum.
+// Ordinals are the positions of the values in the order they are declared, which is unrelated to their underlying values.
+func generateOrdinalMethod(f *jen.File, receiver string, tn *types.TypeName, basic *types.Basic, cs []constNameAndString, opts generateOptions) {
+	f.Commentf("%s returns the zero-based position of %s in the order the values are declared, or -1 if %s is not defined.", methodName(tn, "Ordinal", opts), receiver, receiver)
+	methodDecl(f, jen.Id(receiver).Add(typeRef(tn)), tn, "Ordinal", opts).Int().Block(
+		jen.Switch(jen.Id(receiver)).BlockFunc(func(g *jen.Group) {
+			for i, c := range cs {
+				g.Case(constRef(c)).Block(jen.Return(jen.Lit(i)))
+			}
+			g.Default().Block(jen.Return(jen.Lit(-1)))
+		}),
+	)
+
+	f.Line()
+	f.Commentf("%sFromOrdinal returns the %s at position i in the order the values are declared.", tn.Name(), tn.Name())
+	f.Commentf("An error is returned if i is out of range.")
+	f.Func().Id(tn.Name()+"FromOrdinal").Params(jen.Id("i").Int()).Params(typeRef(tn), jen.Error()).Block(
+		jen.Switch(jen.Id("i")).BlockFunc(func(g *jen.Group) {
+			for i, c := range cs {
+				g.Case(jen.Lit(i)).Block(jen.Return(constRef(c), jen.Nil()))
+			}
+			g.Default().Block(jen.Return(zeroValue(basic), jen.Qual("fmt", "Errorf").Call(jen.Lit("invalid "+tn.Name()+" ordinal: %d"), jen.Id("i"))))
+		}),
+	)
+}
+
+// generateLessMethod generates the Less() method for the enum, which orders values by their ordinals.
+// Values that are not defined don't have an ordinal, so they are ordered after the defined values by their underlying values.
+func generateLessMethod(f *jen.File, receiver string, tn *types.TypeName, otherVarName string, opts generateOptions) {
+	iVarName := safeIndent("i", receiver, otherVarName)
+	jVarName := safeIndent("j", receiver, otherVarName, iVarName)
+
+	f.Commentf("%s reports whether %s is declared before %s, which orders values by their declaration instead of their underlying values.", methodName(tn, "Less", opts), receiver, otherVarName)
+	f.Commentf("Values that are not defined are ordered after the defined values, by their underlying values.")
+	methodDecl(f, jen.Id(receiver).Add(typeRef(tn)), tn, "Less", opts, jen.Id(otherVarName).Add(typeRef(tn))).Bool().Block(
+		jen.List(jen.Id(iVarName), jen.Id(jVarName)).Op(":=").List(methodCall(receiver, tn, "Ordinal", opts), methodCall(otherVarName, tn, "Ordinal", opts)),
+		jen.Switch().Block(
+			jen.Case(jen.Id(iVarName).Op(">=").Lit(0).Op("&&").Id(jVarName).Op(">=").Lit(0)).Block(
+				jen.Return(jen.Id(iVarName).Op("<").Id(jVarName)),
+			),
+			jen.Case(jen.Id(iVarName).Op(">=").Lit(0).Op("||").Id(jVarName).Op(">=").Lit(0)).Block(
+				jen.Return(jen.Id(iVarName).Op(">=").Lit(0)),
+			),
+			jen.Default().Block(
+				jen.Return(jen.Id(receiver).Op("<").Id(otherVarName)),
+			),
+		),
+	)
+}
+
+// generateSortFunction generates the Sort<Types>() function for the enum, which sorts a slice using Less().
+func generateSortFunction(f *jen.File, tn *types.TypeName, opts generateOptions) {
+	name := "Sort" + pluralName(tn.Name())
+
+	less := jen.Id("s").Index(jen.Id("i")).Dot("Less").Call(jen.Id("s").Index(jen.Id("j")))
+	if opts.Functions {
+		less = jen.Id(methodName(tn, "Less", opts)).Call(jen.Id("s").Index(jen.Id("i")), jen.Id("s").Index(jen.Id("j")))
+	}
+
+	f.Commentf("%s sorts s in the order the values are declared, using %s.", name, methodName(tn, "Less", opts))
+	f.Func().Id(name).Params(jen.Id("s").Index().Add(typeRef(tn))).Block(
+		jen.Qual("sort", "Slice").Call(jen.Id("s"), jen.Func().Params(jen.List(jen.Id("i"), jen.Id("j")).Int()).Bool().Block(
+			jen.Return(less),
+		)),
+	)
+}
+
+// generateValuesFunction generates the <Type>Values() and <Type>Strings() functions for the enum.
+// If opts.Values is valuesArray, <Type>Values is an array variable instead of a function.
+func generateValuesFunction(f *jen.File, tn *types.TypeName, cs []constNameAndString, opts generateOptions) {
+	values := jen.ValuesFunc(func(g *jen.Group) {
+		for _, c := range cs {
+			g.Add(constRef(c))
+		}
+	})
+
+	if opts.Values == valuesArray {
+		f.Commentf("%sValues holds all defined %s values in the order they are declared.", tn.Name(), tn.Name())
+		f.Comment("It must not be modified.")
+		f.Var().Id(tn.Name() + "Values").Op("=").Index(jen.Op("...")).Add(typeRef(tn)).Add(values)
+	} else {
+		f.Commentf("%sValues returns all defined %s values in the order they are declared.", tn.Name(), tn.Name())
+		f.Func().Id(tn.Name() + "Values").Params().Index().Add(typeRef(tn)).Block(
+			jen.Return(jen.Index().Add(typeRef(tn)).Add(values)),
+		)
+	}
+
+	f.Line()
+	f.Commentf("%sStrings returns the string representations of all defined %s values in the order they are declared.", tn.Name(), tn.Name())
+	f.Func().Id(tn.Name() + "Strings").Params().Index().String().Block(
+		jen.Return(jen.Index().String().ValuesFunc(func(g *jen.Group) {
+			for _, c := range cs {
+				g.Lit(c.String)
+			}
+		})),
+	)
+}
+
+// generateEntriesFunction generates the _<Type>Entries variable and the <Type>Entries() function for the enum,
+// which pair the string representation of each value with the value.
+func generateEntriesFunction(f *jen.File, tn *types.TypeName, cs []constNameAndString) {
+	varName := entriesVarName(tn)
+	entry := func() *jen.Statement {
+		return jen.Struct(
+			jen.Id("Name").String(),
+			jen.Id("Value").Add(typeRef(tn)),
+		)
+	}
+
+	f.Commentf("%s holds the string representation and value of each defined %s in the order they are declared.", varName, tn.Name())
+	f.Var().Id(varName).Op("=").Index().Add(entry()).CustomFunc(jen.Options{Open: "{", Close: "}", Separator: ",", Multi: true}, func(g *jen.Group) {
+		for _, c := range cs {
+			g.Values(jen.Lit(c.String), constRef(c))
+		}
+	})
+
+	f.Line()
+	f.Commentf("%sEntries returns the string representation and value of each defined %s in the order they are declared.", tn.Name(), tn.Name())
+	f.Comment("The slice is a copy, so it can be modified by the caller.")
+	f.Func().Id(tn.Name() + "Entries").Params().Index().Add(entry()).Block(
+		jen.Return(jen.Append(jen.Id(varName).Index(jen.Empty(), jen.Lit(0), jen.Lit(0)), jen.Id(varName).Op("..."))),
+	)
+}
+
+// entriesVarName returns the name of the variable generated by generateEntriesFunction.
+func entriesVarName(tn *types.TypeName) string {
+	return "_" + tn.Name() + "Entries"
+}
+
+// generateIterFunction generates the <Type>All() function for the enum, which returns a range-over-func
+// iterator over the values of the variable generated by generateEntriesFunction, so that it doesn't allocate.
+func generateIterFunction(f *jen.File, tn *types.TypeName) {
+	f.Commentf("%sAll returns an iterator over all defined %s values in the order they are declared.", tn.Name(), tn.Name())
+	f.Comment("")
+	f.Commentf("\tfor v := range %sAll() {", tn.Name())
+	f.Comment("\t\tfmt.Println(v)")
+	f.Comment("\t}")
+	f.Func().Id(tn.Name()+"All").Params().Qual("iter", "Seq").Types(typeRef(tn)).Block(
+		jen.Return(jen.Func().Params(jen.Id("yield").Func().Params(typeRef(tn)).Bool()).Block(
+			jen.For(jen.List(jen.Id("_"), jen.Id("e")).Op(":=").Range().Id(entriesVarName(tn))).Block(
+				jen.If(jen.Op("!").Id("yield").Call(jen.Id("e").Dot("Value"))).Block(
+					jen.Return(),
+				),
+			),
+		)),
+	)
+}
+
+// generateRandomFunction generates the <Type>Random() function for the enum, which picks
+// one of the values of <Type>Values. Aliases are left out, so every value is equally likely.
+func generateRandomFunction(f *jen.File, tn *types.TypeName, opts generateOptions) {
+	f.Commentf("%sRandom returns a uniformly random defined %s value using r, for use in property tests.", tn.Name(), tn.Name())
+	f.Func().Id(tn.Name()+"Random").Params(jen.Id("r").Op("*").Qual("math/rand", "Rand")).Add(typeRef(tn)).Block(
+		jen.Id("values").Op(":=").Add(valuesRef(tn, opts)),
+		jen.Return(jen.Id("values").Index(jen.Id("r").Dot("Intn").Call(jen.Len(jen.Id("values"))))),
+	)
+}
+
+// generateDebugStringFunction generates the <Type>DebugString() function for the enum, which returns
+// a Name=string(value) line for each constant in cs, including aliases. The string is built when generating.
+func generateDebugStringFunction(f *jen.File, tn *types.TypeName, basic *types.Basic, cs []constNameAndString) {
+	var lines []string
+	for _, c := range cs {
+		lines = append(lines, fmt.Sprintf("%s=%s(%s)", c.Name, c.String, constantLiteral(c.Const.Val(), basic)))
+	}
+
+	f.Commentf("%sDebugString returns a line for each %s constant in the order they are declared, with its name,", tn.Name(), tn.Name())
+	f.Comment("string representation and underlying value, such as Name=string(value). It is meant for debugging.")
+	f.Func().Id(tn.Name() + "DebugString").Params().String().Block(
+		jen.Return(jen.Lit(strings.Join(lines, "\n"))),
+	)
+}
+
+// generateSetType generates the <Type>Set type for collections of enum values.
+// Slice() returns the values in the order they are declared, followed by any values that are not defined.
+func generateSetType(f *jen.File, tn *types.TypeName, opts generateOptions) {
+	setName := tn.Name() + "Set"
+	set := jen.Id("s").Id(setName)
+
+	f.Commentf("%s is a set of %s values.", setName, tn.Name())
+	f.Type().Id(setName).Map(typeRef(tn)).Struct()
+
+	f.Line()
+	f.Commentf("Add adds values to s.")
+	f.Func().Params(set.Clone()).Id("Add").Params(jen.Id("values").Op("...").Add(typeRef(tn))).Block(
+		jen.For(jen.List(jen.Id("_"), jen.Id("v")).Op(":=").Range().Id("values")).Block(
+			jen.Id("s").Index(jen.Id("v")).Op("=").Struct().Values(),
+		),
+	)
+
+	f.Line()
+	f.Commentf("Remove removes values from s.")
+	f.Func().Params(set.Clone()).Id("Remove").Params(jen.Id("values").Op("...").Add(typeRef(tn))).Block(
+		jen.For(jen.List(jen.Id("_"), jen.Id("v")).Op(":=").Range().Id("values")).Block(
+			jen.Delete(jen.Id("s"), jen.Id("v")),
+		),
+	)
+
+	f.Line()
+	f.Commentf("Contains returns true if v is in s.")
+	f.Func().Params(set.Clone()).Id("Contains").Params(jen.Id("v").Add(typeRef(tn))).Bool().Block(
+		jen.List(jen.Id("_"), jen.Id("ok")).Op(":=").Id("s").Index(jen.Id("v")),
+		jen.Return(jen.Id("ok")),
+	)
+
+	f.Line()
+	f.Commentf("Slice returns the values in s in the order they are declared.")
+	f.Commentf("Values that are not defined are sorted after the defined values.")
+	f.Func().Params(set.Clone()).Id("Slice").Params().Index().Add(typeRef(tn)).Block(
+		jen.Id("ret").Op(":=").Make(jen.Index().Add(typeRef(tn)), jen.Lit(0), jen.Len(jen.Id("s"))),
+		jen.For(jen.List(jen.Id("_"), jen.Id("v")).Op(":=").Range().Add(valuesRef(tn, opts))).Block(
+			jen.If(jen.Id("s").Dot("Contains").Call(jen.Id("v"))).Block(
+				jen.Id("ret").Op("=").Append(jen.Id("ret"), jen.Id("v")),
+			),
+		),
+		jen.Line(),
+		jen.Id("n").Op(":=").Len(jen.Id("ret")),
+		jen.For(jen.Id("v").Op(":=").Range().Id("s")).Block(
+			jen.If(methodCall("v", tn, "Ordinal", opts).Op("<").Lit(0)).Block(
+				jen.Id("ret").Op("=").Append(jen.Id("ret"), jen.Id("v")),
+			),
+		),
+		jen.Qual("sort", "Slice").Call(jen.Id("ret").Index(jen.Id("n").Op(":")), jen.Func().Params(jen.List(jen.Id("i"), jen.Id("j")).Int()).Bool().Block(
+			jen.Return(jen.Id("ret").Index(jen.Id("n").Op("+").Id("i")).Op("<").Id("ret").Index(jen.Id("n").Op("+").Id("j"))),
+		)),
+		jen.Line(),
+		jen.Return(jen.Id("ret")),
+	)
+
+	f.Line()
+	f.Commentf("String implements [fmt.Stringer]. The string representations of the values in s are joined with \", \" in the order returned by Slice().")
+	f.Func().Params(set.Clone()).Id("String").Params().String().Block(
+		jen.Id("parts").Op(":=").Make(jen.Index().String(), jen.Lit(0), jen.Len(jen.Id("s"))),
+		jen.For(jen.List(jen.Id("_"), jen.Id("v")).Op(":=").Range().Id("s").Dot("Slice").Call()).Block(
+			jen.Id("parts").Op("=").Append(jen.Id("parts"), methodCall("v", tn, "String", opts)),
+		),
+		jen.Return(jen.Qual("strings", "Join").Call(jen.Id("parts"), jen.Lit(", "))),
+	)
+}
+
+// generateIsPrefixFunction generates the function used by Scan to check whether the input read so far
+// starts a defined string representation.
+func generateIsPrefixFunction(f *jen.File, tn *types.TypeName, opts generateOptions) {
+	var hasPrefix jen.Code
+	if opts.CaseInsensitive {
+		hasPrefix = jen.Len(jen.Id("v")).Op(">=").Len(jen.Id("s")).Op("&&").Qual("strings", "EqualFold").Call(jen.Id("v").Index(jen.Empty(), jen.Len(jen.Id("s"))), jen.Id("s"))
+	} else {
+		hasPrefix = jen.Qual("strings", "HasPrefix").Call(jen.Id("v"), jen.Id("s"))
+	}
+
+	f.Commentf("%s returns true if s is the start of the string representation of a defined %s", isPrefixFuncName(tn), tn.Name())
+	f.Func().Id(isPrefixFuncName(tn)).Params(jen.Id("s").String()).Bool().BlockFunc(func(g *jen.Group) {
+		if opts.Flags {
+			g.Comment("each flag of a combination is checked separately")
+			g.Id("s").Op("=").Id("s").Index(jen.Qual("strings", "LastIndex").Call(jen.Id("s"), jen.Lit("|")).Op("+").Lit(1), jen.Empty())
+			g.Line()
+		}
+
+		g.For(jen.List(jen.Id("_"), jen.Id("v")).Op(":=").Range().Id(validValuesVarName(tn))).Block(
+			jen.If(hasPrefix).Block(
+				jen.Return(jen.True()),
+			),
+		)
+		g.Return(jen.False())
+	})
+}
+
+// isPrefixFuncName returns the name of the function generated by generateIsPrefixFunction.
+func isPrefixFuncName(tn *types.TypeName) string {
+	return "_" + tn.Name() + "IsPrefix"
+}
+
+// generateDefinedMethod generates the Defined() method for the enum.
+func generateDefinedMethod(f *jen.File, receiver string, tn *types.TypeName, basic *types.Basic, cs []constNameAndString, opts generateOptions) {
+	f.Commentf("%s returns true if %s holds a defined value.", methodName(tn, "Defined", opts), receiver)
+	methodDecl(f, receiverParam(receiver, tn, opts), tn, "Defined", opts).Bool().Block(
+		jen.Switch(receiverValue(receiver, opts)).Block(
+			jen.CaseFunc(func(g *jen.Group) {
+				for _, c := range cs {
+					g.Op(c.literal(basic))
+				}
+			}).Block(jen.Return(jen.True())),
+			jen.Default().Block(jen.Return(jen.False())),
+		),
+	)
+}
+
+// predicateName returns the name of the method generated by generatePredicateMethod for c, which is Is
+// followed by the name of c. The name of eType is removed from the start of it if the rest starts with an
+// upper case letter, so that StatusActive and Status_Active become IsActive, but Kind1 becomes IsKind1.
+func predicateName(eType *types.TypeName, c constNameAndString) string {
+	name := c.Name
+	if rest, ok := strings.CutPrefix(name, eType.Name()); ok {
+		rest = strings.TrimLeft(rest, "_")
+		if r, _ := utf8.DecodeRuneInString(rest); unicode.IsUpper(r) {
+			name = rest
+		}
+	}
+
+	return "Is" + exportedName(name)
+}
+
+// checkPredicateNames returns an error if the methods generated by generatePredicateMethod for cs would
+// have the same name, or if one of them is already declared by the package, unless a previous run generated it.
+func checkPredicateNames(eType *types.TypeName, cs []constNameAndString, opts generateOptions) error {
+	seen := make(map[string]string, len(cs))
+	for _, c := range cs {
+		name := predicateName(eType, c)
+		if other, ok := seen[name]; ok {
+			return fmt.Errorf("--emit-predicates generates %s for both %s and %s", name, other, c.Name)
+		}
+		seen[name] = c.Name
+
+		var obj types.Object
+		if opts.Functions {
+			if opts.OutputPkg != "" {
+				continue
+			}
+
+			obj = eType.Pkg().Scope().Lookup(methodName(eType, name, opts))
+		} else {
+			obj, _, _ = types.LookupFieldOrMethod(eType.Type(), true, eType.Pkg(), name)
+		}
+
+		// a previous run generated the method if it is declared in a generated file
+		if obj != nil && findAstFileForToken(obj.Pos(), opts.Generated) == nil {
+			return fmt.Errorf("--emit-predicates generates %s for %s, but it is already declared: %v", methodName(eType, name, opts), c.Name, obj)
+		}
+	}
+
+	return nil
+}
+
+// generatePredicateMethod generates the method that reports whether the receiver is the constant c.
+func generatePredicateMethod(f *jen.File, receiver string, tn *types.TypeName, c constNameAndString, opts generateOptions) {
+	name := predicateName(tn, c)
+	f.Commentf("%s returns true if %s is %s.", methodName(tn, name, opts), receiver, c.Name)
+	methodDecl(f, receiverParam(receiver, tn, opts), tn, name, opts).Bool().Block(
+		jen.Return(receiverValue(receiver, opts).Op("==").Add(constRef(c))),
+	)
+}
+
+// generateValidateMethod generates the Validate() method for the enum.
+func generateValidateMethod(f *jen.File, receiver string, tn *types.TypeName, opts generateOptions) {
+	f.Commentf("%s returns an error if %s does not hold a defined value.", methodName(tn, "Validate", opts), receiver)
+	methodDecl(f, jen.Id(receiver).Add(typeRef(tn)), tn, "Validate", opts).Error().Block(
+		jen.If(jen.Op("!").Add(methodCall(receiver, tn, "Defined", opts))).Block(
+			jen.Return(jen.Qual("fmt", "Errorf").Call(jen.Lit("invalid "+tn.Name()+": %v"), jen.Do(func(s *jen.Statement) {
+				if opts.ReceiverPointer {
+					// only the pointer implements fmt.Stringer
+					s.Op("&")
+				}
+			}).Id(receiver))),
+		),
+		jen.Return(jen.Nil()),
+	)
+}
+
+// anyDescriptions returns true if any of cs has a description.
+func anyDescriptions(cs []constNameAndString) bool {
+	for _, c := range cs {
+		if c.Description != "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// generateDescriptionMethod generates the Description() method for the enum.
+func generateDescriptionMethod(f *jen.File, receiver string, tn *types.TypeName, cs []constNameAndString, opts generateOptions) {
+	f.Commentf("%s returns a human-readable description of %s, or an empty string if %s is not defined or has no description.", methodName(tn, "Description", opts), receiver, receiver)
+	methodDecl(f, jen.Id(receiver).Add(typeRef(tn)), tn, "Description", opts).String().Block(
+		jen.Switch(jen.Id(receiver)).BlockFunc(func(g *jen.Group) {
+			for _, c := range cs {
+				if c.Description == "" {
+					continue
+				}
+				g.Case(constRef(c)).Block(jen.Return(jen.Lit(c.Description)))
+			}
+		}),
+		jen.Return(jen.Lit("")),
+	)
+}
+
+// generateEnumerFunctions generates the <Type>String() function and the IsA<Type>() method of enumer,
+// which are not generated otherwise. enumer's <Type>Values() and String() are generated as usual.
+func generateEnumerFunctions(f *jen.File, receiver string, eType *types.TypeName, varName string) {
+	f.Commentf("%sString is like Parse%s. It is generated for compatibility with enumer.", eType.Name(), eType.Name())
+	f.Func().Id(eType.Name()+"String").Params(jen.Id(varName).String()).Params(jen.Id(eType.Name()), jen.Error()).Block(
+		jen.Return(jen.Id("Parse" + eType.Name()).Call(jen.Id(varName))),
+	)
+
+	f.Line()
+	f.Commentf("IsA%s is like %s.Defined(). It is generated for compatibility with enumer.", eType.Name(), receiver)
+	f.Func().Params(jen.Id(receiver).Id(eType.Name())).Id("IsA" + eType.Name()).Params().Bool().Block(
+		jen.Return(jen.Id(receiver).Dot("Defined").Call()),
+	)
+}
+
+func generateLogValue(f *jen.File, receiver string, eType *types.TypeName) {
+	f.Commentf("LogValue implements [slog.LogValuer]. %s is logged using String()", receiver)
+	f.Func().Params(jen.Id(receiver).Id(eType.Name())).Id("LogValue").Params().Qual("log/slog", "Value").Block(
+		jen.Return(jen.Qual("log/slog", "StringValue").Call(jen.Id(receiver).Dot("String").Call())),
+	)
+}
+
+// generateFormatMethod generates the Format() method for the enum.
+// %v, %s and %q format the string representation, and other verbs format the underlying value.
+func generateFormatMethod(f *jen.File, receiver string, eType *types.TypeName, basic *types.Basic, cs []constNameAndString, stateVarName string, verbVarName string) {
+	state := jen.Id(stateVarName)
+	underlying := jen.Id(basic.Name()).Parens(jen.Id(receiver))
+
+	f.Commentf("Format implements [fmt.Formatter]. %%v, %%s and %%q format %s.String(), and other verbs format the underlying value.", receiver)
+	f.Commentf("%%#v formats the name of the constant as Go syntax, and %%+v adds the underlying value to %s.String().", receiver)
+	f.Func().Params(jen.Id(receiver).Id(eType.Name())).Id("Format").Params(state.Clone().Qual("fmt", "State"), jen.Id(verbVarName).Rune()).Block(
+		jen.Switch(jen.Id(verbVarName)).Block(
+			jen.Case(jen.LitRune('v')).Block(
+				jen.Switch().Block(
+					jen.Case(state.Clone().Dot("Flag").Call(jen.LitRune('#'))).Block(
+						jen.Switch(jen.Id(receiver)).BlockFunc(func(g *jen.Group) {
+							for _, c := range cs {
+								g.Case(jen.Id(c.Name)).Block(
+									jen.Qual("io", "WriteString").Call(state.Clone(), jen.Lit(eType.Pkg().Name()+"."+c.Name)),
+								)
+							}
+							g.Default().Block(
+								jen.Qual("fmt", "Fprintf").Call(state.Clone(), jen.Lit(eType.Pkg().Name()+"."+eType.Name()+"(%#v)"), underlying.Clone()),
+							)
+						}),
+						jen.Return(),
+					),
+					jen.Case(state.Clone().Dot("Flag").Call(jen.LitRune('+')).Op("&&").Id(receiver).Dot("Defined").Call()).Block(
+						jen.Qual("fmt", "Fprintf").Call(state.Clone(), jen.Lit("%s(%#v)"), jen.Id(receiver).Dot("String").Call(), underlying.Clone()),
+						jen.Return(),
+					),
+				),
+				jen.Qual("fmt", "Fprintf").Call(state.Clone(), jen.Qual("fmt", "FormatString").Call(state.Clone(), jen.LitRune('s')), jen.Id(receiver).Dot("String").Call()),
+			),
+			jen.Case(jen.LitRune('s'), jen.LitRune('q')).Block(
+				jen.Qual("fmt", "Fprintf").Call(state.Clone(), jen.Qual("fmt", "FormatString").Call(state.Clone(), jen.Id(verbVarName)), jen.Id(receiver).Dot("String").Call()),
+			),
+			jen.Default().Block(
+				jen.Qual("fmt", "Fprintf").Call(state.Clone(), jen.Qual("fmt", "FormatString").Call(state.Clone(), jen.Id(verbVarName)), underlying.Clone()),
+			),
+		),
+	)
+}
@@ -0,0 +1,304 @@
+package enumgen
+
+import (
+	"go/token"
+	"go/types"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGenerateSet(t *testing.T) {
+	tn, cs, kind := newTestEnum("Kind", types.Int, []string{"Kind1", "Kind2"}, []any{int64(0), int64(1)})
+
+	got := renderTestEnum(t, tn, cs, kind, generateOptions{Set: true})
+	for _, want := range []string{"type KindSet map[Kind]struct{}", "func (s KindSet) Slice() []Kind", "v.Ordinal() < 0", "v.String()"} {
+		if !containsCode(got, want) {
+			t.Errorf("generated code does not contain %q:\n%s", want, got)
+		}
+	}
+
+	got = renderTestEnum(t, tn, cs, kind, generateOptions{Set: true, Functions: true})
+	for _, want := range []string{"KindOrdinal(v) < 0", "KindString(v)"} {
+		if !containsCode(got, want) {
+			t.Errorf("generated code does not contain %q:\n%s", want, got)
+		}
+	}
+}
+
+func TestGeneratePredicates(t *testing.T) {
+	tn, cs, kind := newTestEnum("Status", types.Int, []string{"StatusActive", "Status_Closed", "Status2", "Paused"}, []any{int64(0), int64(1), int64(2), int64(3)})
+	got := renderTestEnum(t, tn, cs, kind, generateOptions{Predicates: true})
+	for _, want := range []string{
+		"func (s Status) IsActive() bool {\n\treturn s == StatusActive\n}",
+		"func (s Status) IsClosed() bool {\n\treturn s == Status_Closed\n}",
+		"func (s Status) IsStatus2() bool {\n\treturn s == Status2\n}",
+		"func (s Status) IsPaused() bool {\n\treturn s == Paused\n}",
+	} {
+		if !containsCode(got, want) {
+			t.Errorf("generated code does not contain %q:\n%s", want, got)
+		}
+	}
+
+	got = renderTestEnum(t, tn, cs, kind, generateOptions{Predicates: true, Functions: true})
+	if want := "func StatusIsActive(s Status) bool {"; !containsCode(got, want) {
+		t.Errorf("generated code with --functions does not contain %q:\n%s", want, got)
+	}
+
+	// both constants would be checked by IsActive
+	tn, cs, kind = newTestEnum("Status", types.Int, []string{"StatusActive", "Active"}, []any{int64(0), int64(1)})
+	if _, err := generateEnumCode(token.NewFileSet(), "example", tn, cs, kind, "s", "go-enumerator", generateOptions{Predicates: true}); err == nil || !strings.Contains(err.Error(), "IsActive for both StatusActive and Active") {
+		t.Errorf("generateEnumCode() = %v, want error for IsActive", err)
+	}
+
+	// methods declared by hand collide with the generated ones
+	fset, info, syntax, pkg := checkTestSource(t, `package example
+
+type Status int
+
+const (
+	StatusActive Status = iota
+	StatusClosed
+)
+
+func (s Status) IsClosed() bool { return s == StatusClosed }
+`)
+	obj := pkg.Scope().Lookup("Status")
+	cs, kind, err := findConstantsOfType(fset, info, syntax, obj, findOptions{CommentTag: "enum", Banner: DefaultBanner})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := generateEnumCode(fset, "example", obj.(*types.TypeName), cs, kind, "s", "go-enumerator", generateOptions{Predicates: true}); err == nil || !strings.Contains(err.Error(), "IsClosed") {
+		t.Errorf("generateEnumCode() = %v, want error for IsClosed", err)
+	}
+}
+
+func TestGenerateMinMax(t *testing.T) {
+	tn, cs, kind := newTestEnum("Kind", types.Int, []string{"Kind1", "Kind2", "Kind3", "Kind4"}, []any{int64(5), int64(-3), int64(9), int64(-3)})
+
+	got := renderTestEnum(t, tn, cs, kind, generateOptions{MinMax: true, AllowAliases: true})
+	for _, want := range []string{"KindMin = Kind2", "KindMax = Kind3"} {
+		if !containsCode(got, want) {
+			t.Errorf("generated code does not contain %q:\n%s", want, got)
+		}
+	}
+
+	tn, cs, kind = newTestEnum("Str", types.String, []string{"StrA", "StrB"}, []any{"a", "b"})
+	if got := renderTestEnum(t, tn, cs, kind, generateOptions{MinMax: true}); containsCode(got, "StrMin") {
+		t.Errorf("generated code contains StrMin for a string enum:\n%s", got)
+	}
+
+	fset, info, syntax, pkg := checkTestSource(t, `package example
+
+type Level int
+
+const (
+	LevelMin Level = iota
+	LevelMax
+)
+`)
+
+	cs, kind, err := findConstantsOfType(fset, info, syntax, pkg.Scope().Lookup("Level"), findOptions{CommentTag: "enum", Banner: DefaultBanner})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tn = pkg.Scope().Lookup("Level").(*types.TypeName)
+	if _, err := generateEnumCode(token.NewFileSet(), "example", tn, cs, kind, "l", "go-enumerator", generateOptions{MinMax: true}); err == nil || !strings.Contains(err.Error(), "LevelMin") {
+		t.Errorf("generateEnumCode() = %v, want error for LevelMin", err)
+	}
+}
+
+func TestGenerateEnumerCompat(t *testing.T) {
+	tn, cs, kind := newTestEnum("Kind", types.Int, []string{"Kind1", "Kind2"}, []any{int64(0), int64(1)})
+	got := renderTestEnum(t, tn, cs, kind, generateOptions{Compat: compatEnumer})
+	for _, want := range []string{
+		"func ParseKind(str string) (Kind, error) {",
+		"func KindString(str string) (Kind, error) {\n\treturn ParseKind(str)\n}",
+		"func (k Kind) IsAKind() bool {\n\treturn k.Defined()\n}",
+		"func KindValues() []Kind {",
+	} {
+		if !containsCode(got, want) {
+			t.Errorf("generated code does not contain %q:\n%s", want, got)
+		}
+	}
+
+	if got := renderTestEnum(t, tn, cs, kind, generateOptions{}); containsCode(got, "IsAKind") {
+		t.Errorf("generated code contains IsAKind without --compat=enumer:\n%s", got)
+	}
+}
+
+func TestGenerateProtoMaps(t *testing.T) {
+	tn, cs, kind := newTestEnum("Kind", types.Int, []string{"Kind1", "Kind2"}, []any{int64(-1), int64(5)})
+	got := renderTestEnum(t, tn, cs, kind, generateOptions{ProtoMaps: true})
+	for _, want := range []string{
+		"var KindNameMap = map[int32]string{",
+		`-1: "Kind1",`,
+		"var KindValueMap = map[string]int32{",
+		`"Kind2": 5,`,
+	} {
+		if !containsCode(got, want) {
+			t.Errorf("generated code does not contain %q:\n%s", want, got)
+		}
+	}
+
+	tests := []struct {
+		basic   types.BasicKind
+		value   any
+		wantErr string
+	}{
+		{types.String, "a", "integer underlying type"},
+		{types.Float64, 0.5, "integer underlying type"},
+		{types.Int64, int64(1) << 40, "fit in an int32"},
+		{types.Uint32, int64(math.MaxUint32), "fit in an int32"},
+	}
+
+	for _, tt := range tests {
+		tn, cs, kind := newTestEnum("Kind", tt.basic, []string{"Kind1"}, []any{tt.value})
+		if _, err := generateEnumCode(token.NewFileSet(), "example", tn, cs, kind, "k", "go-enumerator", generateOptions{ProtoMaps: true}); err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("generateEnumCode(%v) = %v, want error containing %q", tt.value, err, tt.wantErr)
+		}
+	}
+}
+
+func TestGenerateValuesArray(t *testing.T) {
+	tn, cs, kind := newTestEnum("Kind", types.Int, []string{"Kind1", "Kind2"}, []any{int64(0), int64(1)})
+
+	got := renderTestEnum(t, tn, cs, kind, generateOptions{Values: valuesArray, Random: true, Set: true})
+	for _, want := range []string{
+		"var KindValues = [...]Kind{Kind1, Kind2}",
+		"values := KindValues[:]",
+		"for _, v := range KindValues[:] {",
+		"which is len(KindValues).",
+	} {
+		if !containsCode(got, want) {
+			t.Errorf("generated code does not contain %q:\n%s", want, got)
+		}
+	}
+
+	if strings.Contains(got, "func KindValues()") {
+		t.Errorf("generated code declares KindValues() along with the array:\n%s", got)
+	}
+}
+
+func TestGenerateRandom(t *testing.T) {
+	tn, cs, kind := newTestEnum("Kind", types.Int, []string{"Kind1", "Kind2"}, []any{int64(0), int64(1)})
+
+	got := renderTestEnum(t, tn, cs, kind, generateOptions{Random: true})
+	for _, want := range []string{
+		"func KindRandom(r *rand.Rand) Kind",
+		"values := KindValues()",
+		"return values[r.Intn(len(values))]",
+	} {
+		if !containsCode(got, want) {
+			t.Errorf("generated code does not contain %q:\n%s", want, got)
+		}
+	}
+
+	// math/rand is only imported when requested
+	if got := renderTestEnum(t, tn, cs, kind, generateOptions{}); strings.Contains(got, `"math/rand"`) {
+		t.Errorf("generated code imports math/rand without Random:\n%s", got)
+	}
+}
+
+func TestGenerateDebugString(t *testing.T) {
+	tn, cs, kind := newTestEnum("Kind", types.String, []string{"Kind1", "Kind2"}, []any{"a", "b"})
+	cs[1].String = "B"
+	got := renderTestEnum(t, tn, cs, kind, generateOptions{Debug: true})
+	if want := `return "Kind1=Kind1(\"a\")\nKind2=B(\"b\")"`; !containsCode(got, want) {
+		t.Errorf("generated code does not contain %q:\n%s", want, got)
+	}
+
+	if got := renderTestEnum(t, tn, cs, kind, generateOptions{}); containsCode(got, "KindDebugString") {
+		t.Errorf("generated code contains KindDebugString without --emit-debug:\n%s", got)
+	}
+}
+
+func TestGenerateSort(t *testing.T) {
+	tn, cs, kind := newTestEnum("Status", types.Int, []string{"Status1", "Status2"}, []any{int64(0), int64(1)})
+
+	got := renderTestEnum(t, tn, cs, kind, generateOptions{Sort: true})
+	for _, want := range []string{
+		"func (s Status) Less(other Status) bool",
+		"i, j := s.Ordinal(), other.Ordinal()",
+		"func SortStatuses(s []Status)",
+		"return s[i].Less(s[j])",
+	} {
+		if !containsCode(got, want) {
+			t.Errorf("generated code does not contain %q:\n%s", want, got)
+		}
+	}
+
+	got = renderTestEnum(t, tn, cs, kind, generateOptions{Sort: true, Functions: true})
+	for _, want := range []string{
+		"func StatusLess(s Status, other Status) bool",
+		"i, j := StatusOrdinal(s), StatusOrdinal(other)",
+		"return StatusLess(s[i], s[j])",
+	} {
+		if !containsCode(got, want) {
+			t.Errorf("generated code does not contain %q:\n%s", want, got)
+		}
+	}
+}
+
+func TestGenerateIter(t *testing.T) {
+	tn, cs, kind := newTestEnum("Kind", types.Int, []string{"KindA", "KindB"}, []any{int64(0), int64(1)})
+
+	got := renderTestEnum(t, tn, cs, kind, generateOptions{Iter: true})
+	for _, want := range []string{
+		`import (
+	"encoding"
+	"fmt"
+	"iter"`,
+		"func KindAll() iter.Seq[Kind] {",
+		"for _, e := range _KindEntries {",
+		"Ranging over KindAll() is a simpler way to loop through all values.",
+	} {
+		if !containsCode(got, want) {
+			t.Errorf("--emit-iter does not contain %q:\n%s", want, got)
+		}
+	}
+
+	if got := renderTestEnum(t, tn, cs, kind, generateOptions{}); containsCode(got, "iter") {
+		t.Errorf("iter is used without --emit-iter:\n%s", got)
+	}
+
+	dir := t.TempDir()
+	for name, src := range map[string]string{
+		"go.mod": "module example\n\ngo 1.22\n",
+		"kind.go": `package example
+
+type Kind int
+
+const (
+	KindA Kind = 0
+	KindB Kind = 1
+)
+
+func KindAll() []Kind { return nil }
+`,
+	} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(src), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, tt := range []struct {
+		opts    Options
+		wantErr string
+	}{
+		{Options{GoVersion: "1.22"}, "--emit-iter requires Go 1.23 or later"},
+		{Options{GoVersion: "1.23", Flags: true, FlagsHelpers: true}, "--emit-iter cannot be used with --flags-helpers"},
+		{Options{GoVersion: "1.23"}, "--emit-iter generates KindAll, but it is already declared"},
+	} {
+		tt.opts.Dir = dir
+		tt.opts.Type = "Kind"
+		tt.opts.EmitIter = true
+		if _, err := GenerateEnums(tt.opts); err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("GenerateEnums(%+v) = %v, want error containing %q", tt.opts, err, tt.wantErr)
+		}
+	}
+}
@@ -0,0 +1,206 @@
+package enumgen
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// pluralName returns the plural of the type name name, such as Kinds for Kind or Statuses for Status.
+func pluralName(name string) string {
+	switch {
+	case strings.HasSuffix(name, "s"), strings.HasSuffix(name, "x"), strings.HasSuffix(name, "z"),
+		strings.HasSuffix(name, "ch"), strings.HasSuffix(name, "sh"):
+		return name + "es"
+	case strings.HasSuffix(name, "y") && len(name) > 1 && !strings.ContainsRune("aeiouAEIOU", rune(name[len(name)-2])):
+		return name[:len(name)-1] + "ies"
+	default:
+		return name + "s"
+	}
+}
+
+// defaultReceiverName returns the default receiver name to use for tn.
+// The longer styles tell apart the receivers of types such as Kind and Key.
+func defaultReceiverName(tn *types.TypeName, style receiverStyle) string {
+	switch style {
+	case receiverShort:
+		if runes := []rune(tn.Name()); len(runes) > 2 {
+			return strings.ToLower(string(runes[:2]))
+		}
+		return strings.ToLower(tn.Name())
+	case receiverAcronym:
+		var sb strings.Builder
+		for _, w := range splitWords(tn.Name()) {
+			r, _ := utf8.DecodeRuneInString(w)
+			sb.WriteRune(unicode.ToLower(r))
+		}
+		if sb.Len() > 0 {
+			return sb.String()
+		}
+	}
+
+	s, _ := utf8.DecodeRuneInString(tn.Name())
+	return unexportedName(string(s))
+}
+
+// reservedIdents are the identifiers that the generated methods use without
+// going through safeIndent: err, and the names of the imported packages.
+// The receiver is renamed so that it doesn't shadow them.
+var reservedIdents = []string{"err", "binary", "bytes", "driver", "encoding", "errors", "fmt", "io", "json", "math", "rand", "slog", "sort", "sql", "strconv", "strings", "utf8", "xml", "yaml"}
+
+// safeIndent returns an identifier that is safe to use (not a keyword or
+// predeclared identifier, and not already used). want is the requested
+// identifier; not is a list of identifiers that are already used.
+func safeIndent(want string, not ...string) string {
+	if token.IsKeyword(want) || types.Universe.Lookup(want) != nil {
+		return safeIndent("_"+want, not...)
+	}
+
+	for _, s := range not {
+		if want == s {
+			return safeIndent("_"+want, not...)
+		}
+	}
+
+	return want
+}
+
+// exportedName returns s with the first character replaced
+// with its upper case version if it is lower case.
+func exportedName(s string) string {
+	start, size := utf8.DecodeRuneInString(s)
+	if size == 0 {
+		panic("s is empty")
+	}
+
+	start = unicode.ToUpper(start)
+	return string(start) + s[size:]
+}
+
+// displayCase returns the words of name separated by spaces, for the Title Case and Sentence case naming strategies.
+// The first letter of each word is upper case if title is set, and only that of the first word otherwise.
+// Words in upper case, such as HTTP in HTTPServer, are kept as acronyms, unless all the words of name are.
+func displayCase(name string, title bool) string {
+	// names of several words in upper case, such as PENDING_REVIEW, don't contain acronyms
+	words := splitWords(name)
+	acronyms := strings.ToUpper(name) != name || len(words) == 1
+
+	for i, word := range words {
+		if acronyms && strings.ToUpper(word) == word {
+			continue
+		}
+
+		first, size := utf8.DecodeRuneInString(word)
+		rest := strings.ToLower(word[size:])
+		if i == 0 || title {
+			words[i] = string(unicode.ToUpper(first)) + rest
+		} else {
+			words[i] = string(unicode.ToLower(first)) + rest
+		}
+	}
+
+	return strings.Join(words, " ")
+}
+
+// splitWords splits name into words at underscores, hyphens and spaces, and before upper case letters
+// that follow a lower case letter or a digit. A run of upper case letters is kept together as an acronym,
+// except for the last one if it starts a word, such as in HTTPServer. Digits belong to the word before them.
+func splitWords(name string) []string {
+	var words []string
+	var word []rune
+
+	runes := []rune(name)
+	for i, r := range runes {
+		if r == '_' || r == '-' || unicode.IsSpace(r) {
+			if len(word) > 0 {
+				words = append(words, string(word))
+				word = nil
+			}
+			continue
+		}
+
+		if len(word) > 0 && unicode.IsUpper(r) {
+			startsWord := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if !unicode.IsUpper(runes[i-1]) || startsWord {
+				words = append(words, string(word))
+				word = nil
+			}
+		}
+
+		word = append(word, r)
+	}
+
+	if len(word) > 0 {
+		words = append(words, string(word))
+	}
+
+	return words
+}
+
+// commonWordPrefix returns the longest prefix shared by all names that ends where a word starts in each of them,
+// as split by splitWords, so that the rest of every name is left. If there are fewer than two names, nothing is shared.
+func commonWordPrefix(names []string) string {
+	if len(names) < 2 {
+		return ""
+	}
+
+	prefix := names[0]
+	for _, name := range names[1:] {
+		n := 0
+		for n < len(prefix) && n < len(name) && prefix[n] == name[n] {
+			n++
+		}
+		prefix = prefix[:n]
+	}
+
+	for n := len(prefix); n > 0; n-- {
+		if !slices.ContainsFunc(names, func(name string) bool { return !isWordStart(name, n) }) {
+			return prefix[:n]
+		}
+	}
+
+	return ""
+}
+
+// isWordStart returns true if a word of name, as split by splitWords, starts at the byte offset i.
+// The start and the end of name are not considered, since a prefix ending there would trim nothing or everything.
+func isWordStart(name string, i int) bool {
+	if i <= 0 || i >= len(name) || !utf8.RuneStart(name[i]) {
+		return false
+	}
+
+	prev, _ := utf8.DecodeLastRuneInString(name[:i])
+	r, size := utf8.DecodeRuneInString(name[i:])
+	switch {
+	case prev == '_' || prev == '-':
+		return r != '_' && r != '-'
+	case !unicode.IsUpper(r):
+		return false
+	case !unicode.IsUpper(prev):
+		return true
+	}
+
+	// the last upper case letter of an acronym starts the next word
+	next, _ := utf8.DecodeRuneInString(name[i+size:])
+	return unicode.IsLower(next)
+}
+
+// unexportedName returns s with the first character replaced
+// with its lower case version if it is upper case.
+func unexportedName(s string) string {
+	if !ast.IsExported(s) {
+		return s
+	}
+
+	start, size := utf8.DecodeRuneInString(s)
+	if size == 0 {
+		panic("s is empty")
+	}
+
+	start = unicode.ToLower(start)
+	return string(start) + s[size:]
+}
@@ -0,0 +1,102 @@
+package enumgen
+
+import (
+	"go/token"
+	"go/types"
+	"testing"
+)
+
+func TestPluralName(t *testing.T) {
+	for name, want := range map[string]string{
+		"Kind":     "Kinds",
+		"Status":   "Statuses",
+		"Box":      "Boxes",
+		"Match":    "Matches",
+		"Priority": "Priorities",
+		"Day":      "Days",
+	} {
+		if got := pluralName(name); got != want {
+			t.Errorf("pluralName(%q) = %q, want = %q", name, got, want)
+		}
+	}
+}
+
+func TestDefaultReceiverName(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		style receiverStyle
+		want  string
+	}{
+		{"Kind", receiverInitial, "k"},
+		{"Key", receiverInitial, "k"},
+		{"Kind", receiverShort, "ki"},
+		{"Key", receiverShort, "ke"},
+		{"X", receiverShort, "x"},
+		{"ColorMode", receiverAcronym, "cm"},
+		{"HTTPStatus", receiverAcronym, "hs"},
+		{"kind", receiverAcronym, "k"},
+	} {
+		tn := types.NewTypeName(token.NoPos, nil, tt.name, nil)
+		if got := defaultReceiverName(tn, tt.style); got != tt.want {
+			t.Errorf("defaultReceiverName(%q, %s) = %q, want = %q", tt.name, tt.style, got, tt.want)
+		}
+	}
+}
+
+func TestDisplayCase(t *testing.T) {
+	tests := []struct {
+		name     string
+		title    string
+		sentence string
+	}{
+		{"Active", "Active", "Active"},
+		{"PendingReview", "Pending Review", "Pending review"},
+		{"pendingReview", "Pending Review", "Pending review"},
+		{"HTTPServer", "HTTP Server", "HTTP server"},
+		{"ServerHTTP", "Server HTTP", "Server HTTP"},
+		{"UserID", "User ID", "User ID"},
+		{"APIKeyV2", "API Key V2", "API key V2"},
+		{"HTTP2Stream", "HTTP2 Stream", "HTTP2 stream"},
+		{"Kind1", "Kind1", "Kind1"},
+		{"ID", "ID", "ID"},
+		{"PENDING_REVIEW", "Pending Review", "Pending review"},
+		{"pending_review", "Pending Review", "Pending review"},
+		{"pending-review", "Pending Review", "Pending review"},
+	}
+
+	for _, tt := range tests {
+		if got := displayCase(tt.name, true); got != tt.title {
+			t.Errorf("displayCase(%q, true) = %q, want = %q", tt.name, got, tt.title)
+		}
+
+		if got := displayCase(tt.name, false); got != tt.sentence {
+			t.Errorf("displayCase(%q, false) = %q, want = %q", tt.name, got, tt.sentence)
+		}
+	}
+}
+
+func TestCommonWordPrefix(t *testing.T) {
+	tests := []struct {
+		names []string
+		want  string
+	}{
+		{[]string{"ColorRed", "ColorGreen", "ColorBlue"}, "Color"},
+		{[]string{"ColorDarkRed", "ColorDarkGreen"}, "ColorDark"},
+		{[]string{"StatusActive", "StatusActiveOld"}, "Status"},
+		{[]string{"Kind1", "Kind2"}, ""},
+		{[]string{"Red", "Green"}, ""},
+		{[]string{"Colorful", "ColorRed"}, ""},
+		{[]string{"HTTPServer", "HTTPClient"}, "HTTP"},
+		{[]string{"HTTPServer", "HTTPSClient"}, ""},
+		{[]string{"COLOR_RED", "COLOR_GREEN"}, "COLOR_"},
+		{[]string{"color_red", "color_green"}, "color_"},
+		{[]string{"ColorRed"}, ""},
+		{nil, ""},
+	}
+
+	for _, tt := range tests {
+		if got := commonWordPrefix(tt.names); got != tt.want {
+			t.Errorf("commonWordPrefix(%q) = %q, want = %q", tt.names, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,207 @@
+package enumgen
+
+import (
+	"bytes"
+	"fmt"
+	"go/types"
+	"go/version"
+	"strings"
+	"text/template"
+
+	"github.com/stoewer/go-strcase"
+)
+
+type namingStrategyName string
+
+const (
+	none           namingStrategyName = "none"
+	camelCase      namingStrategyName = "camelCase"
+	pascalCase     namingStrategyName = "PascalCase"
+	snakeCase      namingStrategyName = "snake_case"
+	upperSnakeCase namingStrategyName = "UPPER_SNAKE_CASE"
+	kebabCase      namingStrategyName = "kebab-case"
+	titleCase      namingStrategyName = "Title Case"
+	sentenceCase   namingStrategyName = "Sentence case"
+)
+
+type receiverStyle string
+
+const (
+	receiverInitial receiverStyle = "initial"
+	receiverShort   receiverStyle = "short"
+	receiverAcronym receiverStyle = "acronym"
+)
+
+type lookupStrategy string
+
+const (
+	lookupSwitch lookupStrategy = "switch"
+	lookupMap    lookupStrategy = "map"
+)
+
+type valuesStyle string
+
+const (
+	valuesFunc  valuesStyle = "func"
+	valuesArray valuesStyle = "array"
+)
+
+type compatMode string
+
+const (
+	compatNone   compatMode = ""
+	compatEnumer compatMode = "enumer"
+)
+
+type scanStrategy string
+
+const (
+	scanToken  scanStrategy = "token"
+	scanValues scanStrategy = "values"
+)
+
+type msgpackFormat string
+
+const (
+	msgpackNone   msgpackFormat = ""
+	msgpackString msgpackFormat = "string"
+	msgpackInt    msgpackFormat = "int"
+)
+
+type yamlVersion string
+
+const (
+	yamlV2 yamlVersion = "v2"
+	yamlV3 yamlVersion = "v3"
+)
+
+// yamlPackages maps each supported yamlVersion to its import path
+var yamlPackages = map[yamlVersion]string{
+	yamlV2: "gopkg.in/yaml.v2",
+	yamlV3: "gopkg.in/yaml.v3",
+}
+
+// defaultOutputTemplate is the name of the output file when neither --output nor --output-template are specified.
+const defaultOutputTemplate = "{{.Type | unexported}}_enum.go"
+
+// parseOutputTemplate parses text as the template for output file names.
+// If text is empty, defaultOutputTemplate is used.
+func parseOutputTemplate(text string) (*template.Template, error) {
+	if text == "" {
+		text = defaultOutputTemplate
+	}
+
+	t, err := template.New("output").Funcs(template.FuncMap{
+		"lower":      strings.ToLower,
+		"snake":      strcase.SnakeCase,
+		"unexported": unexportedName,
+	}).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --output-template: %w", err)
+	}
+
+	return t, nil
+}
+
+// executeOutputTemplate returns the name of the output file for tn using t.
+func executeOutputTemplate(t *template.Template, tn *types.TypeName, pkgName string) (string, error) {
+	var buf bytes.Buffer
+	err := t.Execute(&buf, struct {
+		Type    string
+		Package string
+	}{
+		Type:    tn.Name(),
+		Package: pkgName,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to execute --output-template: %w", err)
+	}
+
+	if buf.Len() == 0 {
+		return "", fmt.Errorf("--output-template produced an empty file name for %s", tn.Name())
+	}
+
+	return buf.String(), nil
+}
+
+// DefaultCompileCheckLimit is the total length in bytes of the values of a string enum above which the compile check
+// compares each value as a whole instead of byte by byte, unless Options.CompileCheckLimit is set.
+const DefaultCompileCheckLimit = 1024
+
+// DefaultUnknownFormat is the string representation of undefined values, unless Options.UnknownFormat is set.
+const DefaultUnknownFormat = "{{.Type}}({{.Value}})"
+
+// unknownValuePlaceholder is the .Value of --unknown-format. It is replaced by the verb
+// that formats the value once the text is known.
+const unknownValuePlaceholder = "\x00value\x00"
+
+// parseUnknownFormat parses text as the template for the string representation of undefined values.
+func parseUnknownFormat(text string) (*template.Template, error) {
+	t, err := template.New("unknown").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --unknown-format: %w", err)
+	}
+
+	// references to missing fields are only reported when the template is executed
+	if _, err := executeUnknownFormat(t, "Type"); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// executeUnknownFormat returns the text produced by t for the type named typeName,
+// in which unknownValuePlaceholder stands for the value.
+func executeUnknownFormat(t *template.Template, typeName string) (string, error) {
+	var buf bytes.Buffer
+	err := t.Execute(&buf, struct {
+		Type  string
+		Value string
+	}{
+		Type:  typeName,
+		Value: unknownValuePlaceholder,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to execute --unknown-format: %w", err)
+	}
+
+	return buf.String(), nil
+}
+
+// checkStringFormat returns an error if format, the --string-format verb, doesn't format a single integer.
+func checkStringFormat(format string) error {
+	// fmt reports missing and extra arguments, and verbs that don't apply to integers, in the result
+	if s := fmt.Sprintf(format, 0); strings.Contains(s, "%!") {
+		return fmt.Errorf("invalid --string-format %q: must format a single integer, such as %%03d", format)
+	}
+
+	return nil
+}
+
+// parseGoVersion returns s, a Go version such as 1.21 or go1.21.3, in the format used by go/version.
+func parseGoVersion(s string) (string, error) {
+	v := s
+	if !strings.HasPrefix(v, "go") {
+		v = "go" + v
+	}
+
+	if !version.IsValid(v) {
+		return "", fmt.Errorf("--go-version %s is not a valid Go version, such as 1.21", s)
+	}
+
+	return v, nil
+}
+
+// DefaultBanner is the "Code generated" line at the top of generated files, unless Options.Banner is set.
+const DefaultBanner = "Code generated by go-enumerator; DO NOT EDIT."
+
+// checkBanner returns an error if tools won't recognize files with the line banner as generated.
+// See https://go.dev/s/generatedcode for the convention.
+func checkBanner(banner string) error {
+	rest, ok := strings.CutPrefix(banner, "Code generated ")
+	if !ok || !strings.HasSuffix(rest, " DO NOT EDIT.") {
+		return fmt.Errorf("--banner %q does not match \"Code generated ... DO NOT EDIT.\", so tools won't treat the generated files as generated", banner)
+	}
+
+	return nil
+}
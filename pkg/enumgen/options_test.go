@@ -0,0 +1,115 @@
+package enumgen
+
+import (
+	"go/types"
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestParseGoVersion(t *testing.T) {
+	for s, want := range map[string]string{
+		"1.21":      "go1.21",
+		"go1.24":    "go1.24",
+		"1.22.3":    "go1.22.3",
+		"1.21rc1":   "go1.21rc1",
+		"latest":    "",
+		"go1.21.x":  "",
+		"version 1": "",
+	} {
+		got, err := parseGoVersion(s)
+		if (err != nil) != (want == "") || got != want {
+			t.Errorf("parseGoVersion(%q) = %q, %v, want = %q", s, got, err, want)
+		}
+	}
+}
+
+func TestOutputTemplate(t *testing.T) {
+	tn, _, _ := newTestEnum("HTTPMethod", types.Int, []string{"Get"}, []any{int64(0)})
+
+	tests := []struct {
+		text string
+		want string
+	}{
+		{"", "hTTPMethod_enum.go"},
+		{"{{.Type | lower}}_generated.go", "httpmethod_generated.go"},
+		{"zz_{{.Package}}_{{.Type | snake}}.go", "zz_example_http_method.go"},
+	}
+
+	for _, tt := range tests {
+		tmpl, err := parseOutputTemplate(tt.text)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := executeOutputTemplate(tmpl, tn, "example")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got != tt.want {
+			t.Errorf("executeOutputTemplate(%q) = %q, want = %q", tt.text, got, tt.want)
+		}
+	}
+
+	if _, err := parseOutputTemplate("{{.Type"); err == nil {
+		t.Error("parseOutputTemplate() = nil, want error")
+	}
+
+	tmpl, err := parseOutputTemplate("{{.Missing}}.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := executeOutputTemplate(tmpl, tn, "example"); err == nil {
+		t.Error("executeOutputTemplate() = nil, want error")
+	}
+}
+
+func TestStringFormat(t *testing.T) {
+	tn, cs, _ := newTestEnum("Code", types.Uint64, []string{"CodeOK", "CodeMax", "CodeLegacy"}, []any{int64(7), new(big.Int).SetUint64(math.MaxUint64), int64(8)})
+	cs[2].String, cs[2].Override = "legacy", true
+	if err := applyStringFormat(tn, cs, "%03d", "code."); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"code.007", "code.18446744073709551615", "legacy"}
+	for i, c := range cs {
+		if c.String != want[i] {
+			t.Errorf("%s.String = %q, want = %q", c.Name, c.String, want[i])
+		}
+	}
+
+	tn, cs, _ = newTestEnum("Ratio", types.Float64, []string{"RatioHalf"}, []any{0.5})
+	if err := applyStringFormat(tn, cs, "%d", ""); err == nil {
+		t.Error("applyStringFormat() for a float enum = nil, want error")
+	}
+
+	for format, valid := range map[string]bool{
+		"%03d":   true,
+		"0x%02X": true,
+		"%d%%":   true,
+		"code":   false,
+		"%d-%d":  false,
+		"%s":     false,
+		"%03.2f": false,
+		"%[2]d":  false,
+	} {
+		if err := checkStringFormat(format); (err == nil) != valid {
+			t.Errorf("checkStringFormat(%q) = %v, want valid = %t", format, err, valid)
+		}
+	}
+}
+
+func TestCheckBanner(t *testing.T) {
+	for banner, valid := range map[string]bool{
+		DefaultBanner: true,
+		"Code generated by enumgen. DO NOT EDIT.": true,
+		"Generated by go-enumerator":              false,
+		"Code generated by go-enumerator":         false,
+	} {
+		if err := checkBanner(banner); (err == nil) != valid {
+			t.Errorf("checkBanner(%q) = %v, want valid = %v", banner, err, valid)
+		}
+	}
+}
@@ -0,0 +1,331 @@
+package enumgen
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"github.com/dave/jennifer/jen"
+)
+
+// generateScanMethod generates the Scan() method for the enum.
+func generateScanMethod(f *jen.File, tn *types.TypeName, receiver string, scanStateVarName string, verbVarName string, tokenVarName string, runeVarName string, nextVarName string, cs []constNameAndString, parse valueParser, opts generateOptions) {
+	f.Commentf("Scan implements [fmt.Scanner]. Use [fmt.Scan] to parse strings into %s values", tn.Name())
+	if opts.Scan == scanValues {
+		f.Comment("")
+		f.Comment("The longest input that starts a defined string representation is read, so string representations can contain spaces.")
+	}
+
+	f.Func().Params(jen.Id(receiver).Op("*").Id(tn.Name())).Id("Scan").Params(jen.Id(scanStateVarName).Qual("fmt", "ScanState"), jen.Id(verbVarName).Rune()).Error().BlockFunc(func(g *jen.Group) {
+		if opts.Scan == scanValues {
+			generateScanValues(g, tn, scanStateVarName, tokenVarName, runeVarName, nextVarName)
+		} else {
+			g.List(jen.Id(tokenVarName), jen.Err()).Op(":=").Id(scanStateVarName).Dot("Token").Call(jen.True(), jen.Nil())
+			g.If(jen.Err().Op("!=").Nil()).Block(
+				jen.Return(jen.Err()),
+			)
+		}
+
+		g.Line()
+		switch {
+		case opts.Flags:
+			parse.lookupFlags(g, tn, jen.String().Parens(jen.Id(tokenVarName)), jen.Return(invalidValueError(tn, jen.Id(parse.partVarName), opts)))
+
+			g.Line()
+			g.Op("*").Id(receiver).Op("=").Id(parse.vVarName)
+		case parse.usesMap():
+			parse.lookup(g, jen.String().Parens(jen.Id(tokenVarName)), jen.Return(invalidValueError(tn, jen.String().Parens(jen.Id(tokenVarName)), opts)))
+
+			g.Line()
+			g.Op("*").Id(receiver).Op("=").Id(parse.vVarName)
+		default:
+			g.Switch(jen.String().Parens(jen.Id(tokenVarName))).BlockFunc(func(g *jen.Group) {
+				for _, c := range cs {
+					g.Case(jen.Lit(c.String)).Block(
+						jen.Op("*").Id(receiver).Op("=").Id(c.Name),
+					)
+				}
+				g.Default().Block(
+					jen.Return(invalidValueError(tn, jen.String().Parens(jen.Id(tokenVarName)), opts)),
+				)
+			})
+		}
+
+		g.Return(jen.Nil())
+	})
+}
+
+// generateScanValues adds statements to g that read the longest input that starts a defined string
+// representation into tokenVarName. The rune that ends it is unread, so that it is left for the next scan.
+func generateScanValues(g *jen.Group, tn *types.TypeName, scanStateVarName string, tokenVarName string, runeVarName string, nextVarName string) {
+	g.Id(scanStateVarName).Dot("SkipSpace").Call()
+	g.Line()
+	g.Var().Id(tokenVarName).Index().Byte()
+	g.For().Block(
+		jen.List(jen.Id(runeVarName), jen.Id("_"), jen.Err()).Op(":=").Id(scanStateVarName).Dot("ReadRune").Call(),
+		jen.If(jen.Err().Op("==").Qual("io", "EOF")).Block(
+			jen.Break(),
+		),
+		jen.If(jen.Err().Op("!=").Nil()).Block(
+			jen.Return(jen.Err()),
+		),
+		jen.Line(),
+		jen.Id(nextVarName).Op(":=").Qual("unicode/utf8", "AppendRune").Call(jen.Id(tokenVarName), jen.Id(runeVarName)),
+		jen.If(jen.Op("!").Id(isPrefixFuncName(tn)).Call(jen.String().Parens(jen.Id(nextVarName)))).Block(
+			jen.If(jen.Err().Op(":=").Id(scanStateVarName).Dot("UnreadRune").Call(), jen.Err().Op("!=").Nil()).Block(
+				jen.Return(jen.Err()),
+			),
+			jen.Break(),
+		),
+		jen.Id(tokenVarName).Op("=").Id(nextVarName),
+	)
+}
+
+// trimSpace adds a statement to g that removes the leading and trailing spaces of the variable varName
+// if opts.TrimSpace is set. pkg is the package whose TrimSpace function matches the type of varName.
+func trimSpace(g *jen.Group, varName string, pkg string, opts generateOptions) {
+	if !opts.TrimSpace {
+		return
+	}
+
+	g.Id(varName).Op("=").Qual(pkg, "TrimSpace").Call(jen.Id(varName))
+	g.Line()
+}
+
+// generateParseFunction generates the Parse<Type>() function for the enum.
+func generateParseFunction(f *jen.File, eType *types.TypeName, basic *types.Basic, cs []constNameAndString, varName string, parse valueParser, opts generateOptions) {
+	success := []jen.Code{jen.Return(jen.Id(parse.vVarName), jen.Nil())}
+
+	f.Commentf("Parse%s parses %s into a %s. An error is returned if %s is not the string representation of a defined %s.", eType.Name(), varName, eType.Name(), varName, eType.Name())
+	if opts.TrimSpace {
+		f.Commentf("Leading and trailing spaces of %s are ignored.", varName)
+	}
+	if parse.numeric != nil {
+		f.Commentf("%s can also be a defined value written as an integer.", varName)
+	}
+	f.Func().Id("Parse"+eType.Name()).Params(jen.Id(varName).String()).Params(typeRef(eType), jen.Error()).BlockFunc(func(g *jen.Group) {
+		trimSpace(g, varName, "strings", opts)
+		if opts.Flags {
+			parse.lookupFlags(g, eType, jen.Id(varName), parse.orNumber(eType, jen.Id(varName), success, jen.Return(zeroValue(basic), invalidValueError(eType, jen.Id(parse.partVarName), opts)), opts))
+
+			g.Line()
+			g.Return(jen.Id(parse.vVarName), jen.Nil())
+			return
+		}
+
+		if parse.usesMap() {
+			parse.lookup(g, jen.Id(varName), parse.orNumber(eType, jen.Id(varName), success, jen.Return(zeroValue(basic), invalidValueError(eType, jen.Id(varName), opts)), opts))
+
+			g.Line()
+			g.Return(jen.Id(parse.vVarName), jen.Nil())
+			return
+		}
+
+		g.Switch(jen.Id(varName)).BlockFunc(func(g *jen.Group) {
+			for _, c := range cs {
+				g.Case(jen.Lit(c.String)).Block(jen.Return(constRef(c), jen.Nil()))
+			}
+			g.Default().Block(
+				parse.orNumber(eType, jen.Id(varName), success, jen.Return(zeroValue(basic), invalidValueError(eType, jen.Id(varName), opts)), opts),
+			)
+		})
+	})
+
+	f.Line()
+	f.Commentf("MustParse%s is like Parse%s, but panics if %s is not the string representation of a defined %s.", eType.Name(), eType.Name(), varName, eType.Name())
+	f.Commentf("It simplifies the initialization of package-level variables and test fixtures.")
+	f.Func().Id("MustParse"+eType.Name()).Params(jen.Id(varName).String()).Add(typeRef(eType)).Block(
+		jen.List(jen.Id(parse.vVarName), jen.Err()).Op(":=").Id("Parse"+eType.Name()).Call(jen.Id(varName)),
+		jen.If(jen.Err().Op("!=").Nil()).Block(
+			jen.Panic(jen.Qual("fmt", "Errorf").Call(jen.Lit("MustParse"+eType.Name()+": %w"), jen.Err())),
+		),
+		jen.Return(jen.Id(parse.vVarName)),
+	)
+}
+
+// valueParser generates code that parses strings into values by looking them up in a map.
+type valueParser struct {
+	valuesVarName   string       // map of string representations to values, or "" if a switch is used instead
+	caseInsensitive bool         // valuesVarName is keyed by lower case string representations
+	numeric         *types.Basic // underlying type of integer enums that also accept numbers, or nil
+	trimSpace       bool         // the parts of combined flags are looked up without their surrounding spaces
+	partVarName     string
+	flagVarName     string
+	vVarName        string
+	okVarName       string
+	nVarName        string
+}
+
+// usesMap returns true if values are looked up in a map instead of a switch.
+func (p valueParser) usesMap() bool {
+	return p.valuesVarName != ""
+}
+
+// key returns the map key used to look up src.
+func (p valueParser) key(src jen.Code) jen.Code {
+	if p.caseInsensitive {
+		return jen.Qual("strings", "ToLower").Call(src)
+	}
+
+	return src
+}
+
+// orNumber returns fail, preceded by statements that parse src as an integer if p.numeric is set.
+// If the integer is a defined value, it is stored in the variable p.vVarName and success is executed instead.
+func (p valueParser) orNumber(eType *types.TypeName, src jen.Code, success []jen.Code, fail jen.Code, opts generateOptions) jen.Code {
+	if p.numeric == nil {
+		return fail
+	}
+
+	bitSize := 0
+	switch p.numeric.Kind() {
+	case types.Int8, types.Uint8:
+		bitSize = 8
+	case types.Int16, types.Uint16:
+		bitSize = 16
+	case types.Int32, types.Uint32:
+		bitSize = 32
+	case types.Int64, types.Uint64:
+		bitSize = 64
+	}
+
+	parseInt := "ParseInt"
+	if p.numeric.Info()&types.IsUnsigned != 0 {
+		parseInt = "ParseUint"
+	}
+
+	// the value is assigned before calling Defined, which may have a pointer receiver
+	return jen.If(jen.List(jen.Id(p.nVarName), jen.Err()).Op(":=").Qual("strconv", parseInt).Call(src, jen.Lit(10), jen.Lit(bitSize)), jen.Err().Op("==").Nil()).Block(
+		jen.If(jen.Id(p.vVarName).Op(":=").Add(typeRef(eType)).Parens(jen.Id(p.nVarName)), methodCall(p.vVarName, eType, "Defined", opts)).Block(success...),
+	).Line().Line().Add(fail)
+}
+
+// lookup adds statements to g that look up src in the map and store it in the variable p.vVarName.
+// fail is executed if src is not found.
+func (p valueParser) lookup(g *jen.Group, src jen.Code, fail jen.Code) {
+	g.List(jen.Id(p.vVarName), jen.Id(p.okVarName)).Op(":=").Id(p.valuesVarName).Index(p.key(src))
+	g.If(jen.Op("!").Id(p.okVarName)).Block(fail)
+}
+
+// lookupFlags adds statements to g that parse flags in src joined with "|" into the variable p.vVarName.
+// An empty src holds no flags, like the string representation of 0. fail is executed if any of the flags is not defined.
+func (p valueParser) lookupFlags(g *jen.Group, eType *types.TypeName, src jen.Code, fail jen.Code) {
+	var part jen.Code = jen.Id(p.partVarName)
+	if p.trimSpace {
+		part = jen.Qual("strings", "TrimSpace").Call(part)
+	}
+
+	g.Var().Id(p.vVarName).Add(typeRef(eType))
+	g.If(jen.Add(src).Op("!=").Lit("")).Block(
+		jen.For(jen.List(jen.Id("_"), jen.Id(p.partVarName)).Op(":=").Range().Qual("strings", "Split").Call(src, jen.Lit("|"))).Block(
+			jen.List(jen.Id(p.flagVarName), jen.Id(p.okVarName)).Op(":=").Id(p.valuesVarName).Index(p.key(part)),
+			jen.If(jen.Op("!").Id(p.okVarName)).Block(fail),
+			jen.Id(p.vVarName).Op("|=").Id(p.flagVarName),
+		),
+	)
+}
+
+// generateValuesMap generates the map used to look up values by their string representation.
+func generateValuesMap(f *jen.File, eType *types.TypeName, cs []constNameAndString, varName string) {
+	f.Commentf("%s maps the string representation of each %s to its value", varName, eType.Name())
+	f.Var().Id(varName).Op("=").Map(jen.String()).Add(typeRef(eType)).Values(jen.DictFunc(func(d jen.Dict) {
+		for _, c := range cs {
+			d[jen.Lit(c.String)] = constRef(c)
+		}
+	}))
+}
+
+// generateValidValuesVar generates the slice of valid string representations that is listed in parsing errors.
+func generateValidValuesVar(f *jen.File, eType *types.TypeName, cs []constNameAndString) {
+	f.Commentf("%s lists the string representation of each %s in the order they are declared", validValuesVarName(eType), eType.Name())
+	f.Var().Id(validValuesVarName(eType)).Op("=").Index().String().ValuesFunc(func(g *jen.Group) {
+		for _, c := range cs {
+			g.Lit(c.String)
+		}
+	})
+}
+
+// validValuesVarName returns the name of the variable generated by generateValidValuesVar.
+func validValuesVarName(eType *types.TypeName) string {
+	return "_" + eType.Name() + "ValidValues"
+}
+
+// generateInvalidValueErrorType generates the error type that is returned when parsing a string that is not
+// the string representation of a defined value, so that callers can detect it with errors.As.
+// With opts.SentinelError, the sentinel error that is returned instead is generated as well,
+// and the error type unwraps to it.
+func generateInvalidValueErrorType(f *jen.File, eType *types.TypeName, opts generateOptions) {
+	name := invalidValueErrorName(eType)
+	sentinel := sentinelErrorName(eType)
+	if opts.SentinelError {
+		f.Commentf("%s is returned when parsing a string that is not the string representation of a defined %s.", sentinel, eType.Name())
+		f.Commentf("It is allocated once, so that failing to parse doesn't allocate. Wrap it to add details")
+		f.Var().Id(sentinel).Op("=").Qual("errors", "New").Call(jen.Lit("invalid " + eType.Name()))
+		f.Line()
+	}
+
+	f.Commentf("%s is returned when parsing a string that is not the string representation of a defined %s", name, eType.Name())
+	f.Type().Id(name).Struct(
+		jen.Id("Value").String().Comment("the string that could not be parsed"),
+	)
+
+	f.Line()
+	f.Commentf("Error implements the error interface. The message lists the string representations of the defined values")
+	f.Func().Params(jen.Id("e").Op("*").Id(name)).Id("Error").Params().String().Block(
+		jen.Return(jen.Qual("fmt", "Sprintf").Call(
+			jen.Lit("%q is not a valid "+eType.Name()+" (must be one of %s)"),
+			jen.Id("e").Dot("Value"),
+			jen.Qual("strings", "Join").Call(jen.Id(validValuesVarName(eType)), jen.Lit(", ")),
+		)),
+	)
+
+	if opts.SentinelError {
+		f.Line()
+		f.Commentf("Unwrap returns %s, so that errors.Is reports either error as an invalid %s", sentinel, eType.Name())
+		f.Func().Params(jen.Id("e").Op("*").Id(name)).Id("Unwrap").Params().Error().Block(
+			jen.Return(jen.Id(sentinel)),
+		)
+	}
+}
+
+// invalidValueErrorName returns the name of the type generated by generateInvalidValueErrorType,
+// which is only exported if eType is exported.
+func invalidValueErrorName(eType *types.TypeName) string {
+	if !ast.IsExported(eType.Name()) {
+		return "invalid" + exportedName(eType.Name()) + "Error"
+	}
+
+	return "Invalid" + eType.Name() + "Error"
+}
+
+// sentinelErrorName returns the name of the variable generated by generateInvalidValueErrorType
+// with opts.SentinelError, which is only exported if eType is exported.
+func sentinelErrorName(eType *types.TypeName) string {
+	if !ast.IsExported(eType.Name()) {
+		return "errInvalid" + exportedName(eType.Name())
+	}
+
+	return "ErrInvalid" + eType.Name()
+}
+
+// invalidValueError returns an error for the invalid string str, which must be a string expression.
+// With opts.SentinelError, str is ignored and the preallocated sentinel error is returned.
+func invalidValueError(eType *types.TypeName, str jen.Code, opts generateOptions) *jen.Statement {
+	if opts.SentinelError {
+		return jen.Id(sentinelErrorName(eType))
+	}
+
+	return jen.Op("&").Id(invalidValueErrorName(eType)).Values(jen.Dict{
+		jen.Id("Value"): str,
+	})
+}
+
+// generateLowerValuesMap generates the map used to look up values by their lower case string representation.
+func generateLowerValuesMap(f *jen.File, eType *types.TypeName, cs []constNameAndString, varName string) {
+	f.Commentf("%s maps the lower case string representation of each %s to its value", varName, eType.Name())
+	f.Var().Id(varName).Op("=").Map(jen.String()).Add(typeRef(eType)).Values(jen.DictFunc(func(d jen.Dict) {
+		for _, c := range cs {
+			d[jen.Lit(strings.ToLower(c.String))] = constRef(c)
+		}
+	}))
+}
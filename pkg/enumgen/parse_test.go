@@ -0,0 +1,147 @@
+package enumgen
+
+import (
+	"go/token"
+	"go/types"
+	"strings"
+	"testing"
+)
+
+func TestGenerateScanValues(t *testing.T) {
+	// the receiver of Rank is r, so the rune must be named differently
+	tn, cs, kind := newTestEnum("Rank", types.Int, []string{"RankLow", "RankHigh"}, []any{int64(1), int64(2)})
+
+	got := renderTestEnum(t, tn, cs, kind, generateOptions{Scan: scanValues, Flags: true, CaseInsensitive: true})
+	for _, want := range []string{
+		"_r, _, err := scanState.ReadRune()",
+		"next := utf8.AppendRune(token, _r)",
+		"if !_RankIsPrefix(string(next))",
+		`s = s[strings.LastIndex(s, "|")+1:]`,
+		"len(v) >= len(s) && strings.EqualFold(v[:len(s)], s)",
+	} {
+		if !containsCode(got, want) {
+			t.Errorf("generated code does not contain %q:\n%s", want, got)
+		}
+	}
+
+	if got := renderTestEnum(t, tn, cs, kind, generateOptions{}); containsCode(got, "_RankIsPrefix") {
+		t.Errorf("generated code contains _RankIsPrefix without --scan=values:\n%s", got)
+	}
+}
+
+func TestGenerateTrimSpace(t *testing.T) {
+	tn, cs, kind := newTestEnum("Kind", types.Int, []string{"KindA", "KindB"}, []any{int64(0), int64(1)})
+
+	for _, opts := range []generateOptions{{TrimSpace: true}, {TrimSpace: true, Lookup: lookupMap}, {TrimSpace: true, Flags: true}} {
+		got := renderTestEnum(t, tn, cs, kind, opts)
+		if !containsCode(got, "x = bytes.TrimSpace(x)") {
+			t.Errorf("UnmarshalText with %+v does not trim x:\n%s", opts, got)
+		}
+
+		if opts.Lookup == lookupMap && !containsCode(got, "str = strings.TrimSpace(str)") {
+			t.Errorf("ParseKind with %+v does not trim str:\n%s", opts, got)
+		}
+
+		if opts.Flags && !containsCode(got, "flag, ok := _KindValues[strings.TrimSpace(part)]") {
+			t.Errorf("the parts of flags with %+v are not trimmed:\n%s", opts, got)
+		}
+	}
+
+	if got := renderTestEnum(t, tn, cs, kind, generateOptions{}); containsCode(got, "TrimSpace") {
+		t.Errorf("values are trimmed without --trim-space:\n%s", got)
+	}
+
+	cs[1].String = " KindB"
+	err := checkTrimSpaceStrings(tn, cs)
+	if err == nil || !strings.Contains(err.Error(), `" KindB"`) || strings.Contains(err.Error(), `"KindA"`) {
+		t.Errorf("checkTrimSpaceStrings() = %v, want error listing %q", err, " KindB")
+	}
+
+	// --scan=values keeps the leading space from being reported by checkScanStrings
+	if _, err := generateEnumCode(token.NewFileSet(), "example", tn, cs, kind, "k", "go-enumerator", generateOptions{TrimSpace: true, Scan: scanValues, Strict: true}); err == nil {
+		t.Errorf("generateEnumCode() with --trim-space and --strict expected error")
+	}
+
+	if _, err := generateEnumCode(token.NewFileSet(), "example", tn, cs, kind, "k", "go-enumerator", generateOptions{Scan: scanValues, Strict: true}); err != nil {
+		t.Errorf("generateEnumCode() without --trim-space = %v, want nil", err)
+	}
+}
+
+func TestGenerateInvalidValueError(t *testing.T) {
+	tests := []struct {
+		typeName string
+		want     string
+	}{
+		{"Kind", "InvalidKindError"},
+		{"kind", "invalidKindError"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.typeName, func(t *testing.T) {
+			tn, cs, kind := newTestEnum(tt.typeName, types.Int, []string{"Kind1", "Kind2"}, []any{int64(0), int64(1)})
+
+			got := renderTestEnum(t, tn, cs, kind, generateOptions{Lookup: lookupMap})
+			for _, want := range []string{
+				"type " + tt.want + " struct",
+				"func (e *" + tt.want + ") Error() string",
+				"return &" + tt.want + "{Value: string(x)}",
+				"return 0, &" + tt.want + "{Value: str}",
+			} {
+				if !containsCode(got, want) {
+					t.Errorf("generated code does not contain %q:\n%s", want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestGenerateSentinelError(t *testing.T) {
+	tests := []struct {
+		name string
+		want []string
+	}{
+		{"Kind", []string{
+			`var ErrInvalidKind = errors.New("invalid Kind")`,
+			"func (e *InvalidKindError) Unwrap() error {\n\treturn ErrInvalidKind\n}",
+			"default:\n\t\treturn ErrInvalidKind",
+		}},
+		{"kind", []string{
+			`var errInvalidKind = errors.New("invalid kind")`,
+			"default:\n\t\treturn errInvalidKind",
+		}},
+	}
+
+	for _, tt := range tests {
+		tn, cs, kind := newTestEnum(tt.name, types.Int, []string{"Kind1", "Kind2"}, []any{int64(0), int64(1)})
+		got := renderTestEnum(t, tn, cs, kind, generateOptions{SentinelError: true})
+		for _, want := range tt.want {
+			if !containsCode(got, want) {
+				t.Errorf("generated code for %s does not contain %q:\n%s", tt.name, want, got)
+			}
+		}
+
+		if strings.Contains(got, "Error{Value: ") {
+			t.Errorf("generated code for %s allocates an error when parsing fails:\n%s", tt.name, got)
+		}
+	}
+}
+
+func TestGenerateAcceptNumeric(t *testing.T) {
+	tn, cs, kind := newTestEnum("Kind", types.Uint8, []string{"Kind1", "Kind2"}, []any{int64(0), int64(1)})
+
+	got := renderTestEnum(t, tn, cs, kind, generateOptions{AcceptNumeric: true, Functions: true})
+	for _, want := range []string{
+		"if n, err := strconv.ParseUint(str, 10, 8); err == nil {",
+		"if v := Kind(n); KindDefined(v) {\n\t\t\t\treturn v, nil",
+	} {
+		if !containsCode(got, want) {
+			t.Errorf("generated code does not contain %q:\n%s", want, got)
+		}
+	}
+
+	// string enums ignore the flag
+	tn, cs, kind = newTestEnum("Kind", types.String, []string{"Kind1", "Kind2"}, []any{"a", "b"})
+	if got := renderTestEnum(t, tn, cs, kind, generateOptions{AcceptNumeric: true}); strings.Contains(got, "strconv.Parse") {
+		t.Errorf("generated code of a string enum parses numbers:\n%s", got)
+	}
+}
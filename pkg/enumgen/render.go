@@ -0,0 +1,162 @@
+package enumgen
+
+import (
+	"bytes"
+	"go/constant"
+	"go/types"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/dave/jennifer/jen"
+	"golang.org/x/tools/imports"
+)
+
+// typeRef returns a reference to tn that is qualified if the generated file is in a different package.
+func typeRef(tn *types.TypeName) *jen.Statement {
+	return jen.Qual(tn.Pkg().Path(), tn.Name())
+}
+
+// constRef returns a reference to c that is qualified if the generated file is in a different package.
+func constRef(c constNameAndString) *jen.Statement {
+	return jen.Qual(c.Const.Pkg().Path(), c.Name)
+}
+
+// methodName returns the name of the generated method name for eType.
+// If opts.Functions is set, the name of the equivalent function is returned instead.
+func methodName(eType *types.TypeName, name string, opts generateOptions) string {
+	if opts.Functions {
+		return eType.Name() + name
+	}
+
+	return name
+}
+
+// valuesRef returns the slice of defined values generated by generateValuesFunction.
+func valuesRef(tn *types.TypeName, opts generateOptions) *jen.Statement {
+	if opts.Values == valuesArray {
+		return jen.Id(tn.Name() + "Values").Index(jen.Op(":"))
+	}
+
+	return jen.Id(tn.Name() + "Values").Call()
+}
+
+// methodDecl declares the method name with receiver on eType, leaving the results and body to the caller.
+// If opts.Functions is set, a function taking receiver as its first parameter is declared instead.
+func methodDecl(f *jen.File, receiver jen.Code, eType *types.TypeName, name string, opts generateOptions, params ...jen.Code) *jen.Statement {
+	if opts.Functions {
+		return f.Func().Id(methodName(eType, name, opts)).Params(append([]jen.Code{receiver}, params...)...)
+	}
+
+	return f.Func().Params(receiver).Id(name).Params(params...)
+}
+
+// receiverParam returns the receiver of the generated methods that only read the value of eType.
+// If opts.ReceiverPointer is set, the receiver is a pointer to avoid copying the value.
+func receiverParam(receiver string, eType *types.TypeName, opts generateOptions) *jen.Statement {
+	if opts.ReceiverPointer {
+		return jen.Id(receiver).Op("*").Add(typeRef(eType))
+	}
+
+	return jen.Id(receiver).Add(typeRef(eType))
+}
+
+// receiverValue returns the value of a receiver declared with receiverParam.
+func receiverValue(receiver string, opts generateOptions) *jen.Statement {
+	if opts.ReceiverPointer {
+		return jen.Op("*").Id(receiver)
+	}
+
+	return jen.Id(receiver)
+}
+
+// methodCall calls the generated method name on receiver, or its equivalent function if opts.Functions is set.
+func methodCall(receiver string, eType *types.TypeName, name string, opts generateOptions, args ...jen.Code) *jen.Statement {
+	if opts.Functions {
+		return jen.Id(methodName(eType, name, opts)).Call(append([]jen.Code{jen.Id(receiver)}, args...)...)
+	}
+
+	return jen.Id(receiver).Dot(name).Call(args...)
+}
+
+// zeroValue returns the literal to use for the zero value of an enum whose underlying type is basic.
+func zeroValue(basic *types.Basic) *jen.Statement {
+	switch {
+	case basic.Info()&types.IsString != 0:
+		return jen.Lit("")
+	case basic.Info()&(types.IsInteger|types.IsFloat) != 0:
+		return jen.Lit(0)
+	default:
+		panic("invalid constant type")
+	}
+}
+
+// isRune returns true if basic is rune, rather than int32.
+// Values of enums declared with rune are represented as characters instead of numbers.
+func isRune(basic *types.Basic) bool {
+	return basic.Name() == "rune"
+}
+
+// constantLiteral returns val formatted as a Go literal that can be used in expressions
+// involving values of an enum whose underlying type is basic.
+func constantLiteral(val constant.Value, basic *types.Basic) string {
+	if isRune(basic) {
+		if r, ok := constant.Int64Val(val); ok && utf8.ValidRune(rune(r)) && unicode.IsPrint(rune(r)) {
+			return strconv.QuoteRune(rune(r))
+		}
+	}
+
+	if val.Kind() != constant.Float {
+		return val.ExactString()
+	}
+
+	// ExactString returns floats as fractions (e.g. 1/2), which would be evaluated as
+	// integer division. Typed float constants are already rounded to the precision of
+	// their type, so the shortest representation that round-trips is exact enough.
+	bitSize := 64
+	if basic.Kind() == types.Float32 {
+		bitSize = 32
+	}
+
+	f, _ := constant.Float64Val(val)
+	return strconv.FormatFloat(f, 'g', -1, bitSize)
+}
+
+// headerComments turns the lines of Options.Header that are not already comments into line comments.
+func headerComments(lines []string) []string {
+	ret := make([]string, 0, len(lines))
+	for _, line := range lines {
+		switch {
+		case strings.HasPrefix(line, "//"):
+			ret = append(ret, line)
+		case strings.TrimSpace(line) == "":
+			ret = append(ret, "//")
+		default:
+			ret = append(ret, "// "+line)
+		}
+	}
+
+	return ret
+}
+
+// renderOutput renders f and formats it the same way goimports would,
+// so that imports are grouped and unused imports are removed.
+// If formatting fails, the code is returned as rendered by jen, which is still valid.
+func renderOutput(f *jen.File, name string) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := f.Render(&buf); err != nil {
+		return nil, err
+	}
+
+	src, err := imports.Process(name, buf.Bytes(), &imports.Options{
+		Comments:  true,
+		TabIndent: true,
+		TabWidth:  8,
+	})
+	if err != nil {
+		return buf.Bytes(), nil
+	}
+
+	return src, nil
+}
@@ -0,0 +1,45 @@
+package enumgen
+
+import (
+	"fmt"
+	"go/types"
+	"strings"
+	"testing"
+
+	"github.com/dave/jennifer/jen"
+)
+
+func TestRenderOutputGroupsImports(t *testing.T) {
+	f := jen.NewFile("enums")
+	f.Var().Id("_").Op("=").Qual("github.com/a-jentleman/go-enumerator/example", "Kind1")
+	f.Var().Id("_").Op("=").Qual("fmt", "Sprint")
+
+	got, err := renderOutput(f, "kind_enum.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := "import (\n\t\"fmt\"\n\n\texample \"github.com/a-jentleman/go-enumerator/example\"\n)"
+	if !strings.Contains(string(got), want) {
+		t.Errorf("renderOutput() = %s, want imports %s", got, want)
+	}
+}
+
+func TestHeaderComments(t *testing.T) {
+	got := headerComments([]string{"Copyright 2021 Example", "", "// already a comment", "SPDX-License-Identifier: MIT"})
+	want := []string{"// Copyright 2021 Example", "//", "// already a comment", "// SPDX-License-Identifier: MIT"}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("headerComments() = %q, want = %q", got, want)
+	}
+
+	tn, cs, kind := newTestEnum("Kind", types.Int, []string{"Kind1"}, []any{int64(0)})
+	src := renderTestEnum(t, tn, cs, kind, generateOptions{Header: want})
+	if !strings.HasPrefix(src, strings.Join(want, "\n")+"\n// Code generated by go-enumerator; DO NOT EDIT.\n") {
+		t.Errorf("generated code does not start with the header:\n%s", src)
+	}
+
+	src = renderTestEnum(t, tn, cs, kind, generateOptions{Banner: "Code generated by enumgen. DO NOT EDIT."})
+	if !strings.HasPrefix(src, "// Code generated by enumgen. DO NOT EDIT.\n") {
+		t.Errorf("generated code does not start with the banner:\n%s", src)
+	}
+}
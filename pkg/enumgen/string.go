@@ -0,0 +1,219 @@
+package enumgen
+
+import (
+	"fmt"
+	"go/constant"
+	"go/types"
+	"math"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/dave/jennifer/jen"
+)
+
+// generateStringMethod generates the String() method for the enum.
+func generateStringMethod(f *jen.File, receiver string, kind constant.Kind, eType *types.TypeName, basic *types.Basic, cs []constNameAndString, anyOverrides bool, opts generateOptions) {
+	if opts.Functions {
+		f.Commentf("%s returns the string representation of %s. If !%s, then a generated string is returned based on %s's value.", methodName(eType, "String", opts), receiver, methodCall(receiver, eType, "Defined", opts).GoString(), receiver)
+	} else {
+		f.Commentf("String implements [fmt.Stringer]. If !%s.Defined(), then a generated string is returned based on %s's value.", receiver, receiver)
+	}
+
+	var table []constNameAndString
+	if opts.StringerStyle && kind == constant.Int {
+		table = stringTable(cs)
+	}
+
+	switch {
+	case kind == constant.String:
+
+		methodDecl(f, receiverParam(receiver, eType, opts), eType, "String", opts).String().BlockFunc(func(g *jen.Group) {
+			if anyOverrides {
+				g.Switch(receiverValue(receiver, opts)).BlockFunc(func(g *jen.Group) {
+					for _, c := range cs {
+						if c.String == constant.StringVal(c.Const.Val()) {
+							continue
+						}
+
+						g.Case(constRef(c)).Block(jen.Return(jen.Lit(c.String)))
+					}
+				})
+			}
+
+			g.Return(jen.String().Parens(receiverValue(receiver, opts)))
+		})
+
+	case table != nil:
+		// the table is declared after the method, since the comment above must stay attached to it
+		methodDecl(f, receiverParam(receiver, eType, opts), eType, "String", opts).String().BlockFunc(func(g *jen.Group) {
+			v := receiverValue(receiver, opts)
+			g.If(jen.CustomFunc(jen.Options{Separator: " || "}, func(g *jen.Group) {
+				if basic.Info()&types.IsUnsigned == 0 {
+					g.Add(v.Clone()).Op("<").Lit(0)
+				}
+				g.Add(v.Clone()).Op(">").Add(constRef(table[len(table)-1]))
+			})).Block(
+				jen.Return(fallbackString(eType, basic, v.Clone(), opts)),
+			)
+			g.Return(jen.Id(stringTableNameVarName(eType)).Index(
+				jen.Id(stringTableIndexVarName(eType)).Index(v.Clone()).Op(":").Id(stringTableIndexVarName(eType)).Index(v.Clone().Op("+").Lit(1)),
+			))
+		})
+
+		f.Line()
+		generateStringTable(f, eType, table)
+
+	default:
+		methodDecl(f, receiverParam(receiver, eType, opts), eType, "String", opts).String().Block(
+			jen.Switch(receiverValue(receiver, opts)).BlockFunc(func(g *jen.Group) {
+				for _, c := range cs {
+					g.Case(constRef(c)).Block(jen.Return(jen.Lit(c.String)))
+				}
+			}),
+			jen.Return(fallbackString(eType, basic, receiverValue(receiver, opts), opts)),
+		)
+	}
+}
+
+// stringTable returns the constants of cs ordered by value if their values are 0 to n-1, so that
+// their string representations can be looked up by value. Otherwise, nil is returned.
+func stringTable(cs []constNameAndString) []constNameAndString {
+	ret := make([]constNameAndString, len(cs))
+	for _, c := range cs {
+		v, ok := constant.Int64Val(c.Const.Val())
+		if !ok || v < 0 || v >= int64(len(cs)) || ret[v].Const != nil {
+			return nil
+		}
+		ret[v] = c
+	}
+
+	return ret
+}
+
+// stringTableNameVarName and stringTableIndexVarName return the names of the constant and variable generated
+// by generateStringTable.
+func stringTableNameVarName(eType *types.TypeName) string  { return "_" + eType.Name() + "Name" }
+func stringTableIndexVarName(eType *types.TypeName) string { return "_" + eType.Name() + "Index" }
+
+// generateStringTable generates the string representations of table, the constants returned by stringTable,
+// as one string constant, along with the indexes where each of them starts and ends in it. The string
+// representation of the value i is name[index[i]:index[i+1]], which does not allocate.
+func generateStringTable(f *jen.File, eType *types.TypeName, table []constNameAndString) {
+	var name strings.Builder
+	index := []int{0}
+	for _, c := range table {
+		name.WriteString(c.String)
+		index = append(index, name.Len())
+	}
+
+	// the smallest type that can hold the indexes keeps the table small
+	indexType := jen.Uint8()
+	switch {
+	case name.Len() > math.MaxUint16:
+		indexType = jen.Uint32()
+	case name.Len() > math.MaxUint8:
+		indexType = jen.Uint16()
+	}
+
+	f.Const().Id(stringTableNameVarName(eType)).Op("=").Lit(name.String())
+
+	f.Line()
+	f.Var().Id(stringTableIndexVarName(eType)).Op("=").Index(jen.Op("...")).Add(indexType).ValuesFunc(func(g *jen.Group) {
+		for _, i := range index {
+			g.Lit(i)
+		}
+	})
+}
+
+// generateBytesMethod generates the Bytes() method for the enum.
+func generateBytesMethod(f *jen.File, receiver string, kind constant.Kind, eType *types.TypeName, basic *types.Basic, cs []constNameAndString, anyOverrides bool, opts generateOptions) {
+	if opts.Functions {
+		f.Commentf("%s returns a byte-level representation of %s. If !%s, then a generated string is returned based on %s's value.", methodName(eType, "Bytes", opts), methodName(eType, "String", opts), methodCall(receiver, eType, "Defined", opts).GoString(), receiver)
+	} else {
+		f.Commentf("Bytes returns a byte-level representation of String(). If !%s.Defined(), then a generated string is returned based on %s's value.", receiver, receiver)
+	}
+
+	switch kind {
+	case constant.String:
+		methodDecl(f, receiverParam(receiver, eType, opts), eType, "Bytes", opts).Op("[]").Byte().BlockFunc(func(g *jen.Group) {
+			if anyOverrides {
+				g.Switch(receiverValue(receiver, opts)).BlockFunc(func(g *jen.Group) {
+					for _, c := range cs {
+						if c.String == constant.StringVal(c.Const.Val()) {
+							continue
+						}
+
+						g.Case(constRef(c)).Block(jen.Return(jen.Op("[]").Byte().Parens(jen.Lit(c.String))))
+					}
+				})
+			}
+			g.Return(jen.Op("[]").Byte().Parens(receiverValue(receiver, opts)))
+		})
+	default:
+		methodDecl(f, receiverParam(receiver, eType, opts), eType, "Bytes", opts).Op("[]").Byte().Block(
+			jen.Switch(receiverValue(receiver, opts)).BlockFunc(func(g *jen.Group) {
+				for _, c := range cs {
+					g.Case(constRef(c)).Block(jen.ReturnFunc(func(g *jen.Group) {
+						g.Op("[]").Byte().ValuesFunc(func(g *jen.Group) {
+							n := c.String
+							for r, size := utf8.DecodeRuneInString(n); len(n) > 0 && r != utf8.RuneError; r, size = utf8.DecodeRuneInString(n) {
+								if size > 1 {
+									// multi-byte characters don't fit in a byte literal, so their UTF-8 encoding is used
+									for _, b := range []byte(n[:size]) {
+										g.Op(fmt.Sprintf("0x%02x", b))
+									}
+								} else {
+									g.LitRune(r)
+								}
+								n = n[size:]
+							}
+						})
+					}))
+				}
+			}),
+			jen.ReturnFunc(func(g *jen.Group) {
+				if opts.Flags {
+					// combinations of flags are built by String
+					g.Op("[]").Byte().Parens(methodCall(receiver, eType, "String", opts))
+					return
+				}
+				g.Op("[]").Byte().Parens(fallbackString(eType, basic, receiverValue(receiver, opts), opts))
+			}),
+		)
+	}
+}
+
+// fallbackString returns an expression for the string representation of v, a value of eType that is not defined.
+func fallbackString(eType *types.TypeName, basic *types.Basic, v *jen.Statement, opts generateOptions) *jen.Statement {
+	// %d formats both signed and unsigned integers correctly
+	verb := "%d"
+	switch {
+	case basic.Info()&types.IsFloat != 0:
+		verb = "%g"
+	case isRune(basic):
+		// v is converted since %q would otherwise call String
+		verb = "%q"
+		v = jen.Rune().Parens(v)
+	}
+
+	text := eType.Name() + "(" + unknownValuePlaceholder + ")"
+	if opts.UnknownFormat != nil {
+		var err error
+		text, err = executeUnknownFormat(opts.UnknownFormat, eType.Name())
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	if !strings.Contains(text, unknownValuePlaceholder) {
+		return jen.Lit(text)
+	}
+
+	// the rest of the text must not be mistaken for verbs
+	format := strings.ReplaceAll(text, "%", "%%")
+	if strings.Count(format, unknownValuePlaceholder) > 1 {
+		verb = "%[1]" + verb[1:]
+	}
+
+	return jen.Qual("fmt", "Sprintf").Call(jen.Lit(strings.ReplaceAll(format, unknownValuePlaceholder, verb)), v)
+}
@@ -0,0 +1,91 @@
+package enumgen
+
+import (
+	"go/constant"
+	"go/token"
+	"go/types"
+	"testing"
+)
+
+func TestGenerateStringerStyle(t *testing.T) {
+	tn, cs, kind := newTestEnum("Kind", types.Uint8, []string{"Kind2", "Kind1", "Kind3"}, []any{int64(1), int64(0), int64(2)})
+	got := renderTestEnum(t, tn, cs, kind, generateOptions{StringerStyle: true})
+	for _, want := range []string{
+		`const _KindName = "Kind1Kind2Kind3"`,
+		"var _KindIndex = [...]uint8{0, 5, 10, 15}",
+		"if k > Kind3 {",
+		"return _KindName[_KindIndex[k]:_KindIndex[k+1]]",
+	} {
+		if !containsCode(got, want) {
+			t.Errorf("generated code does not contain %q:\n%s", want, got)
+		}
+	}
+
+	tn, cs, kind = newTestEnum("Kind", types.Int, []string{"Kind1", "Kind2"}, []any{int64(0), int64(1)})
+	if got := renderTestEnum(t, tn, cs, kind, generateOptions{StringerStyle: true, ReceiverPointer: true}); !containsCode(got, "if *k < 0 || *k > Kind2 {") {
+		t.Errorf("generated code does not check negative values:\n%s", got)
+	}
+
+	// values that don't start at zero or have gaps use a switch
+	for _, values := range [][]any{{int64(1), int64(2)}, {int64(0), int64(2)}} {
+		tn, cs, kind = newTestEnum("Kind", types.Int, []string{"Kind1", "Kind2"}, values)
+		if got := renderTestEnum(t, tn, cs, kind, generateOptions{StringerStyle: true}); containsCode(got, "_KindName") {
+			t.Errorf("generated code for values %v contains a table:\n%s", values, got)
+		}
+	}
+}
+
+func TestGenerateUnknownFormat(t *testing.T) {
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{DefaultUnknownFormat, `return fmt.Sprintf("Kind(%d)", k)`},
+		{"%!{{.Type}}({{.Value}})", `return fmt.Sprintf("%%!Kind(%d)", k)`},
+		{"{{.Value}} ({{.Value}})", `return fmt.Sprintf("%[1]d (%[1]d)", k)`},
+		{"", `return ""`},
+		{"unknown", `return "unknown"`},
+	}
+
+	tn, cs, kind := newTestEnum("Kind", types.Int, []string{"Kind1", "Kind2"}, []any{int64(0), int64(1)})
+	for _, tt := range tests {
+		unknownFormat, err := parseUnknownFormat(tt.format)
+		if err != nil {
+			t.Fatalf("parseUnknownFormat(%q) = %v", tt.format, err)
+		}
+
+		got := renderTestEnum(t, tn, cs, kind, generateOptions{UnknownFormat: unknownFormat})
+		if !containsCode(got, tt.want) {
+			t.Errorf("generated code for %q does not contain %q:\n%s", tt.format, tt.want, got)
+		}
+	}
+
+	for _, format := range []string{"{{.Type", "{{.Name}}"} {
+		if _, err := parseUnknownFormat(format); err == nil {
+			t.Errorf("parseUnknownFormat(%q) = nil, want error", format)
+		}
+	}
+}
+
+func TestGenerateRune(t *testing.T) {
+	pkg := types.NewPackage("example", "example")
+	tn := types.NewTypeName(token.NoPos, pkg, "Suit", nil)
+	named := types.NewNamed(tn, types.Universe.Lookup("rune").Type(), nil)
+	cs := []constNameAndString{
+		{Const: types.NewConst(token.NoPos, pkg, "SuitSpades", named, constant.MakeInt64('♠')), Name: "SuitSpades", String: "♠"},
+		{Const: types.NewConst(token.NoPos, pkg, "SuitNone", named, constant.MakeInt64(0)), Name: "SuitNone", String: "SuitNone"},
+	}
+
+	got := renderTestEnum(t, tn, cs, constant.Int, generateOptions{})
+	for _, want := range []string{`fmt.Sprintf("Suit(%q)", rune(s))`, "case '♠', 0:", "return []byte{0xe2, 0x99, 0xa0}"} {
+		if !containsCode(got, want) {
+			t.Errorf("generated code does not contain %q:\n%s", want, got)
+		}
+	}
+
+	// int32 enums are still numbers
+	tn, cs, kind := newTestEnum("Kind", types.Int32, []string{"Kind1"}, []any{int64('A')})
+	if got := renderTestEnum(t, tn, cs, kind, generateOptions{}); !containsCode(got, `fmt.Sprintf("Kind(%d)", k)`) {
+		t.Errorf("generated code does not format int32 values as numbers:\n%s", got)
+	}
+}
@@ -0,0 +1,169 @@
+package enumgen
+
+import (
+	"go/types"
+
+	"github.com/dave/jennifer/jen"
+)
+
+// generateEnumTest generates a test file for the code generated by generateEnumCode.
+// For each value, it checks that the value is defined and round-trips through String(),
+// MarshalText() and UnmarshalText(), as well as Parse<Type>() if it is generated.
+// The Is<Name> methods of --emit-predicates are checked by a test of their own.
+func generateEnumTest(pkgName string, tn *types.TypeName, cs []constNameAndString, reproCmd string, opts generateOptions) *jen.File {
+	f := jen.NewFilePathName(tn.Pkg().Path(), pkgName)
+	writeHeader(f, reproCmd, opts)
+
+	tt := jen.Id("tt")
+	f.Func().Id("Test"+tn.Name()+"Enum").Params(jen.Id("t").Op("*").Qual("testing", "T")).Block(
+		jen.Id("tests").Op(":=").Index().Struct(
+			jen.Id("value").Id(tn.Name()),
+			jen.Id("str").String(),
+		).CustomFunc(jen.Options{Open: "{", Close: "}", Separator: ",", Multi: true}, func(g *jen.Group) {
+			for _, c := range canonicalConstants(cs) {
+				g.Values(jen.Id(c.Name), jen.Lit(c.String))
+			}
+		}),
+		jen.Line(),
+		jen.For(jen.List(jen.Id("_"), tt.Clone()).Op(":=").Range().Id("tests")).Block(
+			jen.Id("t").Dot("Run").Call(tt.Clone().Dot("str"), jen.Func().Params(jen.Id("t").Op("*").Qual("testing", "T")).BlockFunc(func(g *jen.Group) {
+				g.If(jen.Op("!").Add(tt.Clone()).Dot("value").Dot("Defined").Call()).Block(
+					jen.Id("t").Dot("Errorf").Call(jen.Lit("Defined() = false, want = true")),
+				)
+				g.Line()
+				g.If(jen.Id("got").Op(":=").Add(tt.Clone()).Dot("value").Dot("String").Call(), jen.Id("got").Op("!=").Add(tt.Clone()).Dot("str")).Block(
+					jen.Id("t").Dot("Errorf").Call(jen.Lit("String() = %v, want = %v"), jen.Id("got"), tt.Clone().Dot("str")),
+				)
+				g.Line()
+				g.List(jen.Id("b"), jen.Err()).Op(":=").Add(tt.Clone()).Dot("value").Dot("MarshalText").Call()
+				g.If(jen.Err().Op("!=").Nil()).Block(
+					jen.Id("t").Dot("Fatal").Call(jen.Err()),
+				)
+				g.Line()
+				g.If(jen.String().Parens(jen.Id("b")).Op("!=").Add(tt.Clone()).Dot("str")).Block(
+					jen.Id("t").Dot("Errorf").Call(jen.Lit("MarshalText() = %s, want = %v"), jen.Id("b"), tt.Clone().Dot("str")),
+				)
+				g.Line()
+				g.Var().Id("got").Id(tn.Name())
+				g.If(jen.Err().Op(":=").Id("got").Dot("UnmarshalText").Call(jen.Id("b")), jen.Err().Op("!=").Nil()).Block(
+					jen.Id("t").Dot("Fatal").Call(jen.Err()),
+				)
+				g.Line()
+				g.If(jen.Id("got").Op("!=").Add(tt.Clone()).Dot("value")).Block(
+					jen.Id("t").Dot("Errorf").Call(jen.Lit("UnmarshalText() = %v, want = %v"), jen.Id("got"), tt.Clone().Dot("value")),
+				)
+
+				if opts.Lookup == lookupMap {
+					g.Line()
+					g.List(jen.Id("parsed"), jen.Err()).Op(":=").Id("Parse" + tn.Name()).Call(tt.Clone().Dot("str"))
+					g.If(jen.Err().Op("!=").Nil()).Block(
+						jen.Id("t").Dot("Fatal").Call(jen.Err()),
+					)
+					g.Line()
+					g.If(jen.Id("parsed").Op("!=").Add(tt.Clone()).Dot("value")).Block(
+						jen.Id("t").Dot("Errorf").Call(jen.Lit("Parse"+tn.Name()+"() = %v, want = %v"), jen.Id("parsed"), tt.Clone().Dot("value")),
+					)
+					g.Line()
+					g.If(jen.Id("parsed").Op(":=").Id("MustParse"+tn.Name()).Call(tt.Clone().Dot("str")), jen.Id("parsed").Op("!=").Add(tt.Clone()).Dot("value")).Block(
+						jen.Id("t").Dot("Errorf").Call(jen.Lit("MustParse"+tn.Name()+"() = %v, want = %v"), jen.Id("parsed"), tt.Clone().Dot("value")),
+					)
+				}
+			})),
+		),
+	)
+
+	if opts.Predicates {
+		f.Line()
+		generatePredicatesTest(f, tn, cs)
+	}
+
+	return f
+}
+
+// generatePredicatesTest generates a test that checks that each predicate generated by generatePredicateMethod
+// only reports the value of its constant.
+func generatePredicatesTest(f *jen.File, tn *types.TypeName, cs []constNameAndString) {
+	v := jen.Id("v")
+	f.Func().Id("Test" + tn.Name() + "Predicates").Params(jen.Id("t").Op("*").Qual("testing", "T")).Block(
+		jen.For(jen.List(jen.Id("_"), v.Clone()).Op(":=").Range().Index().Id(tn.Name()).ValuesFunc(func(g *jen.Group) {
+			for _, c := range canonicalConstants(cs) {
+				g.Id(c.Name)
+			}
+		})).BlockFunc(func(g *jen.Group) {
+			for _, c := range cs {
+				name := predicateName(tn, c)
+				g.If(jen.List(jen.Id("got"), jen.Id("want")).Op(":=").Add(v.Clone()).Dot(name).Call().Op(",").Add(v.Clone()).Op("==").Id(c.Name), jen.Id("got").Op("!=").Id("want")).Block(
+					jen.Id("t").Dot("Errorf").Call(jen.Lit("%v."+name+"() = %v, want = %v"), v.Clone(), jen.Id("got"), jen.Id("want")),
+				)
+			}
+		}),
+	)
+}
+
+// generateEnumBench generates a benchmark file for the code generated by generateEnumCode.
+// Each benchmark cycles through the values in cs, so that the results reflect every case of the
+// generated lookups and the benchmarks stay in sync with the enum when it is regenerated.
+func generateEnumBench(pkgName string, tn *types.TypeName, cs []constNameAndString, reproCmd string, opts generateOptions) *jen.File {
+	f := jen.NewFilePathName(tn.Pkg().Path(), pkgName)
+	writeHeader(f, reproCmd, opts)
+
+	cs = canonicalConstants(cs)
+	multi := jen.Options{Open: "{", Close: "}", Separator: ",", Multi: true}
+	values := jen.Id("values").Op(":=").Index().Id(tn.Name()).CustomFunc(multi, func(g *jen.Group) {
+		for _, c := range cs {
+			g.Id(c.Name)
+		}
+	})
+	strs := jen.Id("strs").Op(":=").Index().String().CustomFunc(multi, func(g *jen.Group) {
+		for _, c := range cs {
+			g.Lit(c.String)
+		}
+	})
+
+	// bench generates a benchmark that runs body with i set to the index of the next value
+	bench := func(name string, decl jen.Code, body ...jen.Code) {
+		f.Func().Id("Benchmark"+name).Params(jen.Id("b").Op("*").Qual("testing", "B")).Block(
+			decl,
+			jen.Line(),
+			jen.Id("b").Dot("ReportAllocs").Call(),
+			jen.For(jen.Id("n").Op(":=").Lit(0), jen.Id("n").Op("<").Id("b").Dot("N"), jen.Id("n").Op("++")).Block(
+				append([]jen.Code{jen.Id("i").Op(":=").Id("n").Op("%").Lit(len(cs))}, body...)...,
+			),
+		)
+		f.Line()
+	}
+
+	bench(tn.Name()+"String", values.Clone(),
+		jen.If(jen.Id("values").Index(jen.Id("i")).Dot("String").Call().Op("==").Lit("")).Block(
+			jen.Id("b").Dot("Fatal").Call(jen.Lit("String() returned an empty string")),
+		),
+	)
+
+	bench(tn.Name()+"MarshalText", values.Clone(),
+		jen.If(jen.List(jen.Id("_"), jen.Err()).Op(":=").Id("values").Index(jen.Id("i")).Dot("MarshalText").Call(), jen.Err().Op("!=").Nil()).Block(
+			jen.Id("b").Dot("Fatal").Call(jen.Err()),
+		),
+	)
+
+	texts := jen.Id("texts").Op(":=").Index().Index().Byte().CustomFunc(multi, func(g *jen.Group) {
+		for _, c := range cs {
+			g.Index().Byte().Parens(jen.Lit(c.String))
+		}
+	})
+	bench(tn.Name()+"UnmarshalText", texts,
+		jen.Var().Id("v").Id(tn.Name()),
+		jen.If(jen.Err().Op(":=").Id("v").Dot("UnmarshalText").Call(jen.Id("texts").Index(jen.Id("i"))), jen.Err().Op("!=").Nil()).Block(
+			jen.Id("b").Dot("Fatal").Call(jen.Err()),
+		),
+	)
+
+	if opts.Lookup == lookupMap {
+		bench("Parse"+tn.Name(), strs,
+			jen.If(jen.List(jen.Id("_"), jen.Err()).Op(":=").Id("Parse"+tn.Name()).Call(jen.Id("strs").Index(jen.Id("i"))), jen.Err().Op("!=").Nil()).Block(
+				jen.Id("b").Dot("Fatal").Call(jen.Err()),
+			),
+		)
+	}
+
+	return f
+}
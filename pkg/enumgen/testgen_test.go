@@ -0,0 +1,70 @@
+package enumgen
+
+import (
+	"bytes"
+	"go/types"
+	"testing"
+)
+
+func TestGenerateEnumTest(t *testing.T) {
+	tn, cs, _ := newTestEnum("Kind", types.Int, []string{"Kind1", "Kind2", "Default"}, []any{int64(0), int64(1), int64(0)})
+
+	var buf bytes.Buffer
+	if err := generateEnumTest("example", tn, cs, "go-enumerator", generateOptions{Lookup: lookupMap}).Render(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	got := buf.String()
+	for _, want := range []string{"func TestKindEnum(t *testing.T)", `{Kind1, "Kind1"}`, `{Kind2, "Kind2"}`, `ParseKind(tt.str)`, `MustParseKind(tt.str)`} {
+		if !containsCode(got, want) {
+			t.Errorf("generated test does not contain %q:\n%s", want, got)
+		}
+	}
+
+	// aliases format as the first declared name, so they can't round-trip
+	if containsCode(got, `{Default, "Default"}`) {
+		t.Errorf("generated test contains alias Default:\n%s", got)
+	}
+
+	buf.Reset()
+	if err := generateEnumTest("example", tn, cs, "go-enumerator", generateOptions{Predicates: true}).Render(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	got = buf.String()
+	for _, want := range []string{"func TestKindPredicates(t *testing.T)", "for _, v := range []Kind{Kind1, Kind2} {", "v.IsDefault(), v == Default"} {
+		if !containsCode(got, want) {
+			t.Errorf("generated test does not contain %q:\n%s", want, got)
+		}
+	}
+}
+
+func TestGenerateEnumBench(t *testing.T) {
+	tn, cs, _ := newTestEnum("Kind", types.Int, []string{"Kind1", "Kind2", "Default"}, []any{int64(0), int64(1), int64(0)})
+
+	render := func(opts generateOptions) string {
+		var buf bytes.Buffer
+		if err := generateEnumBench("example", tn, cs, "go-enumerator", opts).Render(&buf); err != nil {
+			t.Fatal(err)
+		}
+		return buf.String()
+	}
+
+	got := render(generateOptions{Lookup: lookupMap})
+	for _, want := range []string{
+		"func BenchmarkKindString(b *testing.B)",
+		"func BenchmarkKindMarshalText(b *testing.B)",
+		"func BenchmarkKindUnmarshalText(b *testing.B)",
+		"func BenchmarkParseKind(b *testing.B)",
+		"i := n % 2",
+		`[]byte("Kind2")`,
+	} {
+		if !containsCode(got, want) {
+			t.Errorf("generated benchmark does not contain %q:\n%s", want, got)
+		}
+	}
+
+	if got := render(generateOptions{}); containsCode(got, "BenchmarkParseKind") {
+		t.Errorf("generated benchmark contains BenchmarkParseKind without --lookup=map:\n%s", got)
+	}
+}
@@ -0,0 +1,141 @@
+package enumgen
+
+import (
+	"fmt"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// checkExported returns an error if tn or any of the constants in cs are not exported,
+// since they could not be referenced from another package.
+func checkExported(tn *types.TypeName, cs []constNameAndString) error {
+	if !tn.Exported() {
+		return fmt.Errorf("type %s must be exported to be used from another package", tn.Name())
+	}
+
+	for _, c := range cs {
+		if !c.Const.Exported() {
+			return fmt.Errorf("constant %s must be exported to be used from another package", c.Name)
+		}
+	}
+
+	return nil
+}
+
+// collision describes the constants first and c that collide, along with where they are declared if it is known.
+func collision(fset *token.FileSet, first, c constNameAndString) string {
+	describe := func(c constNameAndString) string {
+		if !c.Const.Pos().IsValid() {
+			return c.Name
+		}
+
+		return fmt.Sprintf("%s at %s", c.Name, fset.Position(c.Const.Pos()))
+	}
+
+	return fmt.Sprintf("%s and %s", describe(first), describe(c))
+}
+
+// checkProtoValues returns an error if the values in cs cannot be used in the maps generated by generateProtoMaps.
+func checkProtoValues(tn *types.TypeName, kind constant.Kind, cs []constNameAndString) error {
+	if kind != constant.Int {
+		return fmt.Errorf("--proto-maps requires %s to have an integer underlying type", tn.Name())
+	}
+
+	for _, c := range cs {
+		if v, ok := constant.Int64Val(c.Const.Val()); !ok || v < math.MinInt32 || v > math.MaxInt32 {
+			return fmt.Errorf("--proto-maps requires values that fit in an int32, like protobuf enums, but %s is %s", c.Name, c.Const.Val())
+		}
+	}
+
+	return nil
+}
+
+// checkFlagValues returns an error if the values in cs cannot be used as bit flags.
+// Each value must either be a single bit, or a combination of bits defined by other values.
+func checkFlagValues(tn *types.TypeName, kind constant.Kind, cs []constNameAndString) error {
+	if kind != constant.Int {
+		return fmt.Errorf("--flags requires %s to have an integer underlying type", tn.Name())
+	}
+
+	var bits uint64
+	for _, c := range cs {
+		v, ok := constant.Uint64Val(c.Const.Val())
+		if !ok {
+			return fmt.Errorf("flag %s must not be negative: %s", c.Name, c.Const.Val())
+		}
+
+		if v&(v-1) == 0 {
+			bits |= v
+		}
+	}
+
+	for _, c := range cs {
+		v, _ := constant.Uint64Val(c.Const.Val())
+		if v&^bits != 0 {
+			return fmt.Errorf("flag %s is not a single bit or a combination of other flags: %s", c.Name, c.Const.Val())
+		}
+	}
+
+	return nil
+}
+
+// checkScanStrings returns an error if any string representation in cs contains spaces,
+// which Scan cannot parse back when it reads tokens up to the next space.
+func checkScanStrings(tn *types.TypeName, cs []constNameAndString) error {
+	var spaced []string
+	for _, c := range cs {
+		if strings.IndexFunc(c.String, unicode.IsSpace) >= 0 {
+			spaced = append(spaced, strconv.Quote(c.String))
+		}
+	}
+
+	if len(spaced) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("string representations of %s contain spaces, so Scan cannot parse them: %s; use --scan=values to scan them", tn.Name(), strings.Join(spaced, ", "))
+}
+
+// checkTrimSpaceStrings returns an error if any string representation in cs starts or ends with spaces,
+// which can't be parsed once the spaces are trimmed from the input.
+func checkTrimSpaceStrings(tn *types.TypeName, cs []constNameAndString) error {
+	var padded []string
+	for _, c := range cs {
+		if strings.TrimSpace(c.String) != c.String {
+			padded = append(padded, strconv.Quote(c.String))
+		}
+	}
+
+	if len(padded) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("string representations of %s start or end with spaces, so they can't be parsed with --trim-space: %s", tn.Name(), strings.Join(padded, ", "))
+}
+
+// checkFlagStrings returns an error if any string representation in cs contains "|",
+// which is used to separate the flags of a combination when parsing.
+func checkFlagStrings(tn *types.TypeName, cs []constNameAndString) error {
+	for _, c := range cs {
+		if strings.Contains(c.String, "|") {
+			return fmt.Errorf("string representation %q of %s contains \"|\", so combinations including it can't be parsed", c.String, c.Name)
+		}
+	}
+
+	return nil
+}
+
+// checkComparable returns an error if values of tn can't be compared with ==,
+// which the generated code relies on, such as to use them as map keys.
+func checkComparable(tn *types.TypeName) error {
+	if !types.Comparable(tn.Type()) {
+		return fmt.Errorf("%s is not comparable, so it can't be used as an enum: %v", tn.Name(), tn.Type().Underlying())
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package enumgen
+
+import (
+	"fmt"
+	"go/token"
+	"go/types"
+	"strings"
+	"testing"
+)
+
+func TestGenerateCaseInsensitiveCollision(t *testing.T) {
+	tn, cs, kind := newTestEnum("Kind", types.Int, []string{"Kind1", "KIND1"}, []any{int64(0), int64(1)})
+
+	_, err := generateEnumCode(token.NewFileSet(), "example", tn, cs, kind, "k", "go-enumerator", generateOptions{CaseInsensitive: true})
+	if err == nil || err.Error() != `strings only differ by case: "Kind1" and "KIND1" (Kind1 and KIND1)` {
+		t.Errorf("generateEnumCode() = %v, want case collision error", err)
+	}
+
+	if _, err := generateEnumCode(token.NewFileSet(), "example", tn, cs, kind, "k", "go-enumerator", generateOptions{}); err != nil {
+		t.Errorf("generateEnumCode() = %v, want nil", err)
+	}
+}
+
+func TestCheckFlagValues(t *testing.T) {
+	tests := []struct {
+		name    string
+		values  []any
+		wantErr string
+	}{
+		{"bits", []any{int64(0), int64(1), int64(2), int64(4)}, ""},
+		{"combination", []any{int64(1), int64(2), int64(3)}, ""},
+		{"not a bit", []any{int64(1), int64(2), int64(5)}, "not a single bit"},
+		{"negative", []any{int64(1), int64(-2)}, "must not be negative"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			names := make([]string, len(tt.values))
+			for i := range names {
+				names[i] = fmt.Sprintf("Flag%d", i)
+			}
+
+			tn, cs, kind := newTestEnum("Flag", types.Int, names, tt.values)
+			err := checkFlagValues(tn, kind, cs)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("checkFlagValues() = %v, want nil", err)
+				}
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("checkFlagValues() = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCheckScanStrings(t *testing.T) {
+	tn, cs, kind := newTestEnum("Status", types.Int, []string{"StatusOK", "StatusNotFound"}, []any{int64(200), int64(404)})
+	cs[1].String = "Not Found"
+
+	err := checkScanStrings(tn, cs)
+	if err == nil || !strings.Contains(err.Error(), `"Not Found"`) || strings.Contains(err.Error(), `"StatusOK"`) {
+		t.Errorf("checkScanStrings() = %v, want error listing %q", err, "Not Found")
+	}
+
+	if _, err := generateEnumCode(token.NewFileSet(), "example", tn, cs, kind, "s", "go-enumerator", generateOptions{Strict: true}); err == nil {
+		t.Errorf("generateEnumCode() with --strict expected error")
+	}
+
+	// strings with spaces can be scanned with --scan=values, and Scan isn't generated with --sql
+	for _, opts := range []generateOptions{{Strict: true, Scan: scanValues}, {Strict: true, SQL: true}} {
+		if _, err := generateEnumCode(token.NewFileSet(), "example", tn, cs, kind, "s", "go-enumerator", opts); err != nil {
+			t.Errorf("generateEnumCode(%+v) = %v, want nil", opts, err)
+		}
+	}
+}
+
+func TestCheckComparable(t *testing.T) {
+	tn, cs, kind := newTestEnum("Kind", types.Int, []string{"Kind1", "Kind2"}, []any{int64(0), int64(1)})
+	if err := checkComparable(tn); err != nil {
+		t.Errorf("checkComparable(Kind) = %v", err)
+	}
+
+	if got := renderTestEnum(t, tn, cs, kind, generateOptions{}); !containsCode(got, "_ = map[Kind]struct{}{}") {
+		t.Errorf("generated code does not check that Kind is comparable:\n%s", got)
+	}
+
+	pkg := types.NewPackage("example", "example")
+	field := types.NewField(token.NoPos, pkg, "values", types.NewSlice(types.Typ[types.Int]), false)
+	notComparable := types.NewTypeName(token.NoPos, pkg, "Kind", nil)
+	types.NewNamed(notComparable, types.NewStruct([]*types.Var{field}, nil), nil)
+
+	if err := checkComparable(notComparable); err == nil || !strings.Contains(err.Error(), "not comparable") {
+		t.Errorf("checkComparable() of a struct with a slice = %v, want error", err)
+	}
+}